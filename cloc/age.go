@@ -0,0 +1,99 @@
+package cloc
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+// AgeBuckets name the code age buckets, newest first
+var AgeBuckets = []string{"<1 month", "<6 months", "<1 year", "<3 years", "older"}
+
+// ageLimits are the upper bounds of every age bucket but the oldest
+var ageLimits = []time.Duration{30 * day, 182 * day, 365 * day, 3 * 365 * day}
+
+// AgeBucket returns the index in AgeBuckets for code last changed age ago
+func AgeBucket(age time.Duration) int {
+	for i, limit := range ageLimits {
+		if age < limit {
+			return i
+		}
+	}
+	return len(ageLimits)
+}
+
+// AgeCode sums the code lines of the files with a known age into age
+// buckets, or returns nil if no file has one
+func AgeCode(files []FileInfo, now time.Time) []int {
+	var code []int
+	for _, file := range files {
+		if file.Modified.IsZero() {
+			continue
+		}
+		if code == nil {
+			code = make([]int, len(AgeBuckets))
+		}
+		code[AgeBucket(now.Sub(file.Modified))] += file.Code
+	}
+	return code
+}
+
+// ActiveShare returns the fraction of the code in age buckets changed within
+// the last year
+func ActiveShare(ageCode []int) float64 {
+	active, total := 0, 0
+	for i, code := range ageCode {
+		if i < AgeBucket(365*day) {
+			active += code
+		}
+		total += code
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(active) / float64(total)
+}
+
+// GitFileTimes reads when each file in the repository holding path, or in a
+// git ref, was last changed, keyed like GitChurn
+func GitFileTimes(path string, isGit bool) (map[string]time.Time, error) {
+	args := []string{"log", "--name-only", "--no-renames", "--format=%x00%ct"}
+	var tree workTree
+	if isGit {
+		args = append(args, path)
+	} else {
+		tree = openWorkTree(path)
+		args = append([]string{"-C", tree.root}, args...)
+	}
+	output, err := gitOutput(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each commit, newest first, is a NUL and its unix time followed by the
+	// paths it changed, so the first time seen for a path is its last change
+	times := make(map[string]time.Time)
+	var commitTime time.Time
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if stamp, ok := strings.CutPrefix(line, "\x00"); ok {
+			if secs, err := strconv.ParseInt(stamp, 10, 64); err == nil {
+				commitTime = time.Unix(secs, 0)
+			}
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		name := tree.historyPath(line, isGit)
+		if _, seen := times[name]; !seen {
+			times[name] = commitTime
+		}
+	}
+	return times, nil
+}
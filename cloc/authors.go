@@ -0,0 +1,194 @@
+package cloc
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Authorship maps file paths, as cloc reports them without a leading "./",
+// to the lines each author contributed
+type Authorship map[string]map[string]int
+
+// Author is the lines one author contributed, broken down by language and
+// by top-level directory
+type Author struct {
+	Name      string
+	Lines     int
+	Files     int
+	Languages []LineShare // Most lines first
+	Dirs      []LineShare // Most lines first
+}
+
+// LineShare is the lines an author contributed to one language or directory
+type LineShare struct {
+	Name  string
+	Lines int
+}
+
+// GitBlame attributes the current lines of files, as cloc reports them, to
+// their authors with git blame, running up to workers blames at once. Files
+// git can't blame, such as untracked ones, are left out. Authors are mapped
+// through the repository's mailmap.
+func GitBlame(files []string, path string, isGit bool, workers int) Authorship {
+	var tree workTree
+	if !isGit {
+		tree = openWorkTree(path)
+	}
+
+	paths := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	authorship := make(Authorship)
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range paths {
+				if lines := blameFile(file, path, tree, isGit); lines != nil {
+					mu.Lock()
+					authorship[strings.TrimPrefix(file, "./")] = lines
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, file := range files {
+		paths <- file
+	}
+	close(paths)
+	wg.Wait()
+	return authorship
+}
+
+// blameFile counts the lines of one file by author
+func blameFile(file, ref string, tree workTree, isGit bool) map[string]int {
+	var cmd *exec.Cmd
+	if isGit {
+		cmd = exec.Command("git", "blame", "--line-porcelain", ref, "--", strings.TrimPrefix(file, "./"))
+	} else {
+		rel, err := tree.relPath(file)
+		if err != nil {
+			return nil
+		}
+		cmd = exec.Command("git", "-C", tree.root, "blame", "--line-porcelain", "--", rel)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	// Every line has a header including "author <name>"
+	lines := make(map[string]int)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		if name, ok := strings.CutPrefix(scanner.Text(), "author "); ok {
+			lines[name]++
+		}
+	}
+	return lines
+}
+
+// GitNumstat approximates authorship from the lines each author added in
+// the history, which is much faster than blame but counts lines since
+// deleted. Authors are mapped through the repository's mailmap.
+func GitNumstat(path string, isGit bool) (Authorship, error) {
+	args := []string{"log", "--numstat", "--no-renames", "--format=@%aN"}
+	var tree workTree
+	if isGit {
+		args = append(args, path)
+	} else {
+		tree = openWorkTree(path)
+		args = append([]string{"-C", tree.root}, args...)
+	}
+	output, err := gitOutput(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each commit is "@<author>" followed by "<added>\t<deleted>\t<path>" lines
+	authorship := make(Authorship)
+	author := ""
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if name, ok := strings.CutPrefix(line, "@"); ok {
+			author = name
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		added, err := strconv.Atoi(fields[0])
+		if err != nil || added == 0 {
+			continue
+		}
+		name := tree.historyPath(fields[2], isGit)
+		if authorship[name] == nil {
+			authorship[name] = make(map[string]int)
+		}
+		authorship[name][author] += added
+	}
+	return authorship, nil
+}
+
+// Authors sums the authorship of the files in the result by author, most
+// lines first. dirOf names the directory a file is grouped under.
+func (r *Result) Authors(authorship Authorship, dirOf func(FileInfo) string) []Author {
+	type tally struct {
+		Author
+		languages map[string]int
+		dirs      map[string]int
+	}
+	tallies := make(map[string]*tally)
+	for _, lang := range r.Languages {
+		for _, file := range r.Files[lang.Name] {
+			for name, lines := range authorship[strings.TrimPrefix(file.Path, "./")] {
+				t := tallies[name]
+				if t == nil {
+					t = &tally{Author: Author{Name: name}, languages: make(map[string]int), dirs: make(map[string]int)}
+					tallies[name] = t
+				}
+				t.Lines += lines
+				t.Files++
+				t.languages[file.Language] += lines
+				t.dirs[dirOf(file)] += lines
+			}
+		}
+	}
+
+	authors := make([]Author, 0, len(tallies))
+	for _, t := range tallies {
+		t.Languages = sortShares(t.languages)
+		t.Dirs = sortShares(t.dirs)
+		authors = append(authors, t.Author)
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Lines != authors[j].Lines {
+			return authors[i].Lines > authors[j].Lines
+		}
+		return authors[i].Name < authors[j].Name
+	})
+	return authors
+}
+
+// sortShares lists lines by name, most lines first
+func sortShares(lines map[string]int) []LineShare {
+	shares := make([]LineShare, 0, len(lines))
+	for name, n := range lines {
+		shares = append(shares, LineShare{Name: name, Lines: n})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].Lines != shares[j].Lines {
+			return shares[i].Lines > shares[j].Lines
+		}
+		return shares[i].Name < shares[j].Name
+	})
+	return shares
+}
@@ -0,0 +1,124 @@
+package cloc
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// Bucket holds the files sorted into one named bucket, with their totals
+// overall and per language
+type Bucket struct {
+	Name      string
+	Total     LanguageStats
+	Languages []LanguageStats // By code lines, largest first
+	Files     []FileInfo
+}
+
+// Buckets sorts every file into the buckets named by bucketsOf; a file in
+// several buckets counts toward each. Buckets are returned in the order of
+// names, followed by any other bucket by name; buckets without files are
+// left out.
+func (r *Result) Buckets(names []string, bucketsOf func(FileInfo) []string) []Bucket {
+	byName := make(map[string]*Bucket)
+	langs := make(map[string]map[string]*LanguageStats)
+	for _, lang := range r.Languages {
+		for _, file := range r.Files[lang.Name] {
+			for _, name := range bucketsOf(file) {
+				b, ok := byName[name]
+				if !ok {
+					b = &Bucket{Name: name, Total: LanguageStats{Name: name}}
+					byName[name] = b
+					langs[name] = make(map[string]*LanguageStats)
+				}
+				b.Files = append(b.Files, file)
+				addFile(&b.Total, file)
+
+				stats, ok := langs[name][file.Language]
+				if !ok {
+					stats = &LanguageStats{Name: file.Language}
+					langs[name][file.Language] = stats
+				}
+				addFile(stats, file)
+			}
+		}
+	}
+
+	order := make([]string, 0, len(byName))
+	listed := make(map[string]bool, len(names))
+	for _, name := range names {
+		if _, ok := byName[name]; ok && !listed[name] {
+			order = append(order, name)
+		}
+		listed[name] = true
+	}
+	var rest []string
+	for name := range byName {
+		if !listed[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+
+	buckets := make([]Bucket, 0, len(byName))
+	for _, name := range append(order, rest...) {
+		b := byName[name]
+		for _, stats := range langs[name] {
+			b.Languages = append(b.Languages, *stats)
+		}
+		sort.Slice(b.Languages, func(i, j int) bool {
+			if b.Languages[i].Code != b.Languages[j].Code {
+				return b.Languages[i].Code > b.Languages[j].Code
+			}
+			return b.Languages[i].Name < b.Languages[j].Name
+		})
+		buckets = append(buckets, *b)
+	}
+	return buckets
+}
+
+// addFile adds a file's line counts to stats
+func addFile(stats *LanguageStats, file FileInfo) {
+	stats.Files++
+	stats.Blank += file.Blank
+	stats.Comment += file.Comment
+	stats.Code += file.Code
+}
+
+// MatchGlob reports whether a slash-separated relative path matches a glob
+// pattern. Besides the path.Match syntax, "**" matches any number of
+// directories, a pattern without a slash matches the file name at any
+// depth, and a pattern ending in a slash matches everything under it.
+func MatchGlob(pattern, name string) bool {
+	pattern = strings.TrimPrefix(pattern, "/")
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// matchSegments matches path segments against pattern segments
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// Try every number of directories, including none
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
@@ -0,0 +1,113 @@
+package cloc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Churn is how much a file changed over the history window
+type Churn struct {
+	Commits int // Commits touching the file
+	Added   int
+	Deleted int
+}
+
+// Hotspot is a file ranked by how often it changes and how big it is
+type Hotspot struct {
+	File FileInfo
+	Churn
+	Score int // Commits times code lines
+}
+
+// GitChurn reads the commit history of the repository holding path, or of
+// a git ref, since a git date such as "6 months ago" (all history when
+// empty). Churn is keyed by file path as cloc reports it, without a
+// leading "./".
+func GitChurn(path string, isGit bool, since string) (map[string]Churn, error) {
+	args := []string{"log", "--numstat", "--no-renames", "--format="}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+	var tree workTree
+	if isGit {
+		args = append(args, path)
+	} else {
+		tree = openWorkTree(path)
+		args = append([]string{"-C", tree.root}, args...)
+	}
+	output, err := gitOutput(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lines look like "<added>\t<deleted>\t<path>", with "-" counts for binaries
+	churn := make(map[string]Churn)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		name := tree.historyPath(fields[2], isGit)
+		c := churn[name]
+		c.Commits++
+		if added, err := strconv.Atoi(fields[0]); err == nil {
+			c.Added += added
+		}
+		if deleted, err := strconv.Atoi(fields[1]); err == nil {
+			c.Deleted += deleted
+		}
+		churn[name] = c
+	}
+	return churn, nil
+}
+
+// gitOutput runs git, returning its error message on failure. Paths in the
+// output are left unquoted.
+func gitOutput(args ...string) ([]byte, error) {
+	args = append([]string{"-c", "core.quotePath=false"}, args...)
+	output, err := exec.Command("git", args...).Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return nil, errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return output, err
+}
+
+// historyPath converts a path from git output, relative to the root, to the
+// form cloc reports: unchanged for a git ref, and under the scanned path as
+// given for a directory
+func (t workTree) historyPath(name string, isGit bool) string {
+	if isGit {
+		return name
+	}
+	return t.filePath(name)
+}
+
+// Hotspots ranks the files that changed in the churn by commits times code
+// lines, highest first. Files without code are left out.
+func (r *Result) Hotspots(churn map[string]Churn) []Hotspot {
+	var hotspots []Hotspot
+	for _, lang := range r.Languages {
+		for _, file := range r.Files[lang.Name] {
+			c, ok := churn[strings.TrimPrefix(file.Path, "./")]
+			if !ok || file.Code == 0 {
+				continue
+			}
+			hotspots = append(hotspots, Hotspot{File: file, Churn: c, Score: c.Commits * file.Code})
+		}
+	}
+	sort.SliceStable(hotspots, func(i, j int) bool {
+		a, b := hotspots[i], hotspots[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.File.Path < b.File.Path
+	})
+	return hotspots
+}
@@ -2,17 +2,24 @@ package cloc
 
 import (
 	"encoding/json"
+	"os"
 	"os/exec"
 	"sort"
+	"time"
 )
 
 // FileInfo contains line count information for a single file
 type FileInfo struct {
-	Path     string `json:"-"`
-	Blank    int    `json:"blank"`
-	Comment  int    `json:"comment"`
-	Code     int    `json:"code"`
-	Language string `json:"language"`
+	Path      string    `json:"-"`
+	Blank     int       `json:"blank"`
+	Comment   int       `json:"comment"`
+	Code      int       `json:"code"`
+	Language  string    `json:"language"`
+	Bytes     int64     `json:"-"` // Size on disk, or of the blob for git refs
+	Owners    []string  `json:"-"` // Owners from CODEOWNERS
+	Test      bool      `json:"-"` // Matched a test file pattern
+	Modified  time.Time `json:"-"` // Last commit changing the file, if known
+	Submodule string    `json:"-"` // Name of the submodule holding the file, if any
 }
 
 // LanguageStats contains aggregate statistics for a language
@@ -26,9 +33,12 @@ type LanguageStats struct {
 
 // Result contains the complete cloc analysis result
 type Result struct {
-	Languages []LanguageStats
-	Files     map[string][]FileInfo // Files grouped by language
-	Total     LanguageStats
+	Languages  []LanguageStats
+	Files      map[string][]FileInfo // Files grouped by language
+	Total      LanguageStats
+	Members    map[string][]LanguageStats // Languages merged into each group
+	OwnersFile string                     // CODEOWNERS file used, if any
+	Submodules []Submodule                // Submodules and nested repositories scanned
 }
 
 // IsGitRef checks if the input looks like a git reference (hash or branch name)
@@ -65,10 +75,51 @@ func Run(path string, isGit bool) (*Result, error) {
 
 	// Merge results
 	summaryResult.Files = fileResult.Files
+	fillSizes(summaryResult, path, isGit)
+	summaryResult.Submodules = findSubmodules(path, isGit, summaryResult.Files)
+	if isGit {
+		addSubmoduleFiles(summaryResult)
+	}
+	markSubmodules(summaryResult, isGit)
+	fillOwners(summaryResult, path, isGit)
 
 	return summaryResult, nil
 }
 
+// Count executes cloc on the given path for the counts alone, summing the
+// languages from the files. It skips the sizes, submodules and owners Run
+// adds, and runs cloc once rather than twice.
+func Count(path string, isGit bool) (*Result, error) {
+	result, err := runClocByFile(path, isGit)
+	if err != nil {
+		return nil, err
+	}
+	result.Total.Name = "SUM"
+	for name, files := range result.Files {
+		stats := LanguageStats{Name: name, Files: len(files)}
+		for _, file := range files {
+			stats.Blank += file.Blank
+			stats.Comment += file.Comment
+			stats.Code += file.Code
+		}
+		result.Languages = append(result.Languages, stats)
+		result.Total.Files += stats.Files
+		result.Total.Blank += stats.Blank
+		result.Total.Comment += stats.Comment
+		result.Total.Code += stats.Code
+	}
+
+	// Sort languages by code lines (descending), as cloc does
+	sort.Slice(result.Languages, func(i, j int) bool {
+		a, b := result.Languages[i], result.Languages[j]
+		if a.Code != b.Code {
+			return a.Code > b.Code
+		}
+		return a.Name < b.Name
+	})
+	return result, nil
+}
+
 func runClocSummary(path string, isGit bool) (*Result, error) {
 	args := []string{"--json"}
 	if isGit {
@@ -162,3 +213,104 @@ func runClocByFile(path string, isGit bool) (*Result, error) {
 
 	return result, nil
 }
+
+// RunFile executes cloc on a single file and returns its counts, or nil if
+// cloc no longer recognizes the file
+func RunFile(path string) (*FileInfo, error) {
+	fileResult, err := runClocByFile(path, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, files := range fileResult.Files {
+		if len(files) > 0 {
+			info := files[0]
+			info.Path = path
+			if stat, err := os.Stat(path); err == nil {
+				info.Bytes = stat.Size()
+			}
+			return &info, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateFile replaces the counts for a file, keeping the language stats and
+// totals in sync. A nil info removes the file from the result.
+func (r *Result) UpdateFile(path string, info *FileInfo) {
+search:
+	for lang, files := range r.Files {
+		for i, file := range files {
+			if file.Path != path {
+				continue
+			}
+			r.adjustLanguage(file, -1)
+			if info != nil && info.Owners == nil {
+				// Ownership and submodule depend on the path, which hasn't changed
+				updated := *info
+				updated.Owners = file.Owners
+				updated.Submodule = file.Submodule
+				info = &updated
+			}
+			if info != nil && info.Language == lang {
+				files[i] = *info
+				r.adjustLanguage(*info, 1)
+				return
+			}
+			r.Files[lang] = append(files[:i:i], files[i+1:]...)
+			if len(r.Files[lang]) == 0 {
+				delete(r.Files, lang)
+			}
+			break search
+		}
+	}
+
+	if info != nil {
+		r.Files[info.Language] = append(r.Files[info.Language], *info)
+		r.adjustLanguage(*info, 1)
+	}
+}
+
+// adjustLanguage adds (sign 1) or subtracts (sign -1) a file's counts from
+// its language and the totals, dropping languages that have no files left
+func (r *Result) adjustLanguage(file FileInfo, sign int) {
+	idx := -1
+	for i, lang := range r.Languages {
+		if lang.Name == file.Language {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		r.Languages = append(r.Languages, LanguageStats{Name: file.Language})
+		idx = len(r.Languages) - 1
+	}
+
+	for _, stats := range []*LanguageStats{&r.Languages[idx], &r.Total} {
+		stats.Files += sign
+		stats.Blank += sign * file.Blank
+		stats.Comment += sign * file.Comment
+		stats.Code += sign * file.Code
+	}
+
+	if r.Languages[idx].Files <= 0 {
+		r.Languages = append(r.Languages[:idx], r.Languages[idx+1:]...)
+	}
+}
+
+// Filter returns a copy of the result with only the languages keep accepts,
+// and the total recomputed from them
+func (r *Result) Filter(keep func(LanguageStats) bool) *Result {
+	filtered := &Result{Files: make(map[string][]FileInfo), OwnersFile: r.OwnersFile}
+	for _, lang := range r.Languages {
+		if !keep(lang) {
+			continue
+		}
+		filtered.Languages = append(filtered.Languages, lang)
+		filtered.Files[lang.Name] = r.Files[lang.Name]
+		filtered.Total.Files += lang.Files
+		filtered.Total.Blank += lang.Blank
+		filtered.Total.Comment += lang.Comment
+		filtered.Total.Code += lang.Code
+	}
+	return filtered
+}
@@ -0,0 +1,198 @@
+package cloc
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// CodeOwnersPaths are the locations GitHub reads CODEOWNERS from, in order
+var CodeOwnersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeOwners maps paths to their owners by the rules of a CODEOWNERS file
+type CodeOwners struct {
+	rules []ownerRule
+}
+
+// ownerRule is one line of a CODEOWNERS file
+type ownerRule struct {
+	pattern []string // Path segments, "**" matching any number of them
+	dirOnly bool     // The pattern only matches directories
+	flat    bool     // The pattern only matches files, as in "docs/*"
+	owners  []string
+}
+
+// ParseCodeOwners parses a CODEOWNERS file. Lines are a gitignore-style
+// pattern followed by owners; a pattern without owners leaves the paths it
+// matches unowned.
+func ParseCodeOwners(data []byte) *CodeOwners {
+	c := &CodeOwners{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(stripComment(scanner.Text()))
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		rule := ownerRule{owners: fields[1:], dirOnly: strings.HasSuffix(pattern, "/")}
+		pattern = strings.TrimSuffix(pattern, "/")
+		// Patterns with a slash are relative to the root, others match at any depth
+		if !strings.HasPrefix(pattern, "/") && !strings.Contains(pattern, "/") {
+			pattern = "**/" + pattern
+		}
+		rule.pattern = strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+		rule.flat = rule.pattern[len(rule.pattern)-1] == "*"
+		c.rules = append(c.rules, rule)
+	}
+	return c
+}
+
+// stripComment removes the comment from a CODEOWNERS line, which starts at a
+// "#" at the beginning of the line or after whitespace. "\#" stands for a
+// literal "#".
+func stripComment(line string) string {
+	var b strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case strings.HasPrefix(line[i:], `\#`):
+			b.WriteByte('#')
+			i++
+		case line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return b.String()
+		default:
+			b.WriteByte(line[i])
+		}
+	}
+	return b.String()
+}
+
+// Owners returns the owners of a slash-separated path relative to the
+// repository root. The last matching rule wins, as on GitHub.
+func (c *CodeOwners) Owners(path string) []string {
+	segments := strings.Split(path, "/")
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if c.rules[i].matches(segments) {
+			return c.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// matches reports whether the rule matches the path or one of its directories
+func (r ownerRule) matches(segments []string) bool {
+	for n := len(segments); n > 0; n-- {
+		if (n == len(segments) && r.dirOnly) || (n < len(segments) && r.flat) {
+			continue
+		}
+		if matchSegments(r.pattern, segments[:n]) {
+			return true
+		}
+	}
+	return false
+}
+
+// fillOwners sets the owners of every file from the repository's CODEOWNERS
+// file, recording which file was used
+func fillOwners(r *Result, path string, isGit bool) {
+	var owners *CodeOwners
+	var tree workTree
+	if isGit {
+		for _, name := range CodeOwnersPaths {
+			if data, err := exec.Command("git", "show", path+":"+name).Output(); err == nil {
+				owners, r.OwnersFile = ParseCodeOwners(data), name
+				break
+			}
+		}
+	} else {
+		tree = openWorkTree(path)
+		for _, name := range CodeOwnersPaths {
+			if data, err := os.ReadFile(filepath.Join(tree.root, name)); err == nil {
+				owners, r.OwnersFile = ParseCodeOwners(data), filepath.Join(tree.root, name)
+				break
+			}
+		}
+	}
+	if owners == nil {
+		return
+	}
+
+	for _, files := range r.Files {
+		for i := range files {
+			rel := strings.TrimPrefix(files[i].Path, "./")
+			if !isGit {
+				var err error
+				if rel, err = tree.relPath(files[i].Path); err != nil {
+					continue
+				}
+			}
+			files[i].Owners = owners.Owners(filepath.ToSlash(rel))
+		}
+	}
+}
+
+// repoRoot returns the top of the git work tree containing path, or path
+// itself outside a repository
+func repoRoot(path string) string {
+	dir := path
+	if stat, err := os.Stat(path); err == nil && !stat.IsDir() {
+		dir = filepath.Dir(path)
+	}
+	output, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return path
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// workTree maps between file paths as cloc reports them for a scanned
+// directory and slash-separated paths relative to the root of the git work
+// tree holding it. git resolves symlinks in the root, so the scanned path is
+// resolved too before the two are compared.
+type workTree struct {
+	root     string // Symlinks resolved
+	path     string // The scanned path as given
+	resolved string // The scanned path with symlinks resolved
+}
+
+// openWorkTree finds the work tree holding path. Outside a repository the
+// root is path itself.
+func openWorkTree(path string) workTree {
+	return workTree{root: resolvePath(repoRoot(path)), path: path, resolved: resolvePath(path)}
+}
+
+// resolvePath makes a path absolute and resolves its symlinks, leaving it
+// as it is where that fails
+func resolvePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return path
+}
+
+// relPath returns the path of a file under the scanned path relative to the
+// root, slash-separated as git writes it
+func (t workTree) relPath(file string) (string, error) {
+	rel, err := filepath.Rel(t.path, file)
+	if err != nil {
+		return "", err
+	}
+	rel, err = filepath.Rel(t.root, filepath.Join(t.resolved, rel))
+	return filepath.ToSlash(rel), err
+}
+
+// filePath returns the path cloc reports for a path relative to the root
+func (t workTree) filePath(name string) string {
+	abs := filepath.Join(t.root, filepath.FromSlash(name))
+	rel, err := filepath.Rel(t.resolved, abs)
+	if err != nil {
+		return abs
+	}
+	return filepath.Join(t.path, rel)
+}
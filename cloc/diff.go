@@ -0,0 +1,408 @@
+package cloc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DiffKind selects which changes a diff counts
+type DiffKind int
+
+const (
+	WorktreeDiff  DiffKind = iota // Unstaged changes: the working tree against the index
+	StagedDiff                    // Staged changes: the index against HEAD
+	MergeBaseDiff                 // Commits on HEAD, or a git ref, since it branched from Base
+)
+
+// Diff names the changes to count
+type Diff struct {
+	Kind DiffKind
+	Base string // Branch HEAD is compared with, for MergeBaseDiff
+}
+
+// String describes the changes, e.g. "staged changes"
+func (d Diff) String() string {
+	switch d.Kind {
+	case StagedDiff:
+		return "staged changes"
+	case MergeBaseDiff:
+		return "changes since " + d.Base
+	}
+	return "unstaged changes"
+}
+
+// LineCounts are lines by kind
+type LineCounts struct {
+	Blank   int
+	Comment int
+	Code    int
+}
+
+func (c *LineCounts) addCounts(o LineCounts) {
+	c.Blank += o.Blank
+	c.Comment += o.Comment
+	c.Code += o.Code
+}
+
+// FileChange is the lines added to and removed from one file
+type FileChange struct {
+	Path     string // As cloc reports it
+	Language string
+	Added    LineCounts
+	Removed  LineCounts
+}
+
+// LanguageChange is the lines added and removed across a language's files
+type LanguageChange struct {
+	Name    string
+	Files   int
+	Added   LineCounts
+	Removed LineCounts
+}
+
+// Changes is a diff counted by language and by file, most code changed first
+type Changes struct {
+	Files     []FileChange
+	Languages []LanguageChange
+	Total     LanguageChange
+}
+
+// fileDiff is the paths of one changed file, relative to the repository
+// root; a path is empty when the file was added or deleted
+type fileDiff struct {
+	oldPath, newPath string
+}
+
+// GitDiff counts the lines a diff adds and removes in the repository holding
+// path, limited to path, by language. For a git ref, which only has a merge
+// base diff, the ref takes the place of HEAD and the whole repository is
+// compared. cloc compares the old and new version of each changed file, so
+// lines count as code, comments or blanks by its own rules, and a line it
+// finds modified counts as both removed and added. languageOf names the
+// language of a path as cloc reports it, or returns "" for files cloc
+// doesn't count, which are left out.
+func GitDiff(path string, isGit bool, diff Diff, languageOf func(string) string) (*Changes, error) {
+	head := "HEAD"
+	if isGit {
+		if diff.Kind != MergeBaseDiff {
+			return nil, fmt.Errorf("a git ref has no %s", diff)
+		}
+		head, path = path, "."
+	}
+	tree := openWorkTree(path)
+	root := tree.root
+	// git takes the path relative to the root it resolved
+	pathspec := ""
+	if !isGit {
+		pathspec = tree.resolved
+	}
+	// Outside a repository git diff would compare paths instead
+	if _, err := gitOutput("-C", root, "rev-parse", "--git-dir"); err != nil {
+		return nil, err
+	}
+	args := []string{"-C", root, "diff", "--no-ext-diff", "--no-renames", "--name-status", "-z"}
+	// The revisions the old and new side are read from: ":" is the index and
+	// "" the working tree
+	var oldRev, newRev string
+	switch diff.Kind {
+	case WorktreeDiff:
+		oldRev, newRev = ":", ""
+	case StagedDiff:
+		oldRev, newRev = "HEAD", ":"
+		args = append(args, "--cached")
+	case MergeBaseDiff:
+		base, err := mergeBase(root, diff.Base, head)
+		if err != nil {
+			return nil, err
+		}
+		oldRev, newRev = base, head
+		args = append(args, oldRev, newRev)
+	}
+	if pathspec != "" {
+		args = append(args, "--", pathspec)
+	}
+	output, err := gitOutput(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Write both versions of the counted files side by side for cloc
+	dir, err := os.MkdirTemp("", "gloc-diff-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	languages := make(map[string]string)
+	for _, fd := range parseNameStatus(output) {
+		name := fd.newPath
+		if name == "" {
+			name = fd.oldPath
+		}
+		lang := languageOf(tree.historyPath(name, isGit))
+		if lang == "" {
+			continue
+		}
+		languages[name] = lang
+		if fd.oldPath != "" {
+			if err := writeRevision(root, oldRev, fd.oldPath, filepath.Join(dir, "old")); err != nil {
+				return nil, err
+			}
+		}
+		if fd.newPath != "" {
+			if err := writeRevision(root, newRev, fd.newPath, filepath.Join(dir, "new")); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(languages) == 0 {
+		return &Changes{}, nil
+	}
+	for _, side := range []string{"old", "new"} {
+		if err := os.MkdirAll(filepath.Join(dir, side), 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := runClocDiff(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := &Changes{}
+	byLang := make(map[string]*LanguageChange)
+	for name, file := range files {
+		file.Language = languages[name]
+		if file.Language == "" {
+			continue
+		}
+		file.Path = tree.historyPath(name, isGit)
+		changes.Files = append(changes.Files, *file)
+
+		lang := byLang[file.Language]
+		if lang == nil {
+			lang = &LanguageChange{Name: file.Language}
+			byLang[file.Language] = lang
+		}
+		lang.Files++
+		lang.Added.addCounts(file.Added)
+		lang.Removed.addCounts(file.Removed)
+		changes.Total.Files++
+		changes.Total.Added.addCounts(file.Added)
+		changes.Total.Removed.addCounts(file.Removed)
+	}
+
+	for _, lang := range byLang {
+		changes.Languages = append(changes.Languages, *lang)
+	}
+	sort.Slice(changes.Languages, func(i, j int) bool {
+		a, b := changes.Languages[i], changes.Languages[j]
+		if a.Added.Code+a.Removed.Code != b.Added.Code+b.Removed.Code {
+			return a.Added.Code+a.Removed.Code > b.Added.Code+b.Removed.Code
+		}
+		return a.Name < b.Name
+	})
+	sort.Slice(changes.Files, func(i, j int) bool {
+		a, b := changes.Files[i], changes.Files[j]
+		if a.Added.Code+a.Removed.Code != b.Added.Code+b.Removed.Code {
+			return a.Added.Code+a.Removed.Code > b.Added.Code+b.Removed.Code
+		}
+		return a.Path < b.Path
+	})
+	return changes, nil
+}
+
+// runClocDiff runs cloc's diff on the old and new directories under dir and
+// returns the lines added and removed in each file, by its path under them.
+// Modified lines count as both.
+func runClocDiff(dir string) (map[string]*FileChange, error) {
+	// Identical files must still be counted on both sides
+	cmd := exec.Command("cloc", "--diff", "--by-file", "--json", "--skip-uniqueness", "old", "new")
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var report map[string]json.RawMessage
+	if err := json.Unmarshal(output, &report); err != nil {
+		return nil, err
+	}
+	files := make(map[string]*FileChange)
+	for _, category := range []string{"added", "removed", "modified"} {
+		raw, ok := report[category]
+		if !ok {
+			continue
+		}
+		var counts map[string]LineCounts
+		if err := json.Unmarshal(raw, &counts); err != nil {
+			return nil, fmt.Errorf("reading cloc's %s lines: %w", category, err)
+		}
+		for key, c := range counts {
+			name := diffName(key)
+			if name == "" {
+				continue
+			}
+			file := files[name]
+			if file == nil {
+				file = &FileChange{}
+				files[name] = file
+			}
+			if category != "removed" {
+				file.Added.addCounts(c)
+			}
+			if category != "added" {
+				file.Removed.addCounts(c)
+			}
+		}
+	}
+	return files, nil
+}
+
+// diffName returns the path of a file in cloc's by-file diff under the old or
+// new directory. cloc may name a pair of files as "old/a | new/a".
+func diffName(key string) string {
+	for _, side := range strings.Split(key, " | ") {
+		side = filepath.ToSlash(strings.TrimSpace(side))
+		if name, ok := strings.CutPrefix(side, "new/"); ok {
+			return name
+		}
+		if name, ok := strings.CutPrefix(side, "old/"); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// MergeBase returns the commit head branched from base, in the repository
+// of the working directory
+func MergeBase(base, head string) (string, error) {
+	return mergeBase(".", base, head)
+}
+
+func mergeBase(root, base, head string) (string, error) {
+	output, err := gitOutput("-C", root, "merge-base", base, head)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// parseNameStatus reads the files of a diff made with --name-status -z,
+// where each status is followed by the path it applies to
+func parseNameStatus(output []byte) []fileDiff {
+	var files []fileDiff
+	fields := strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		status, name := fields[i], fields[i+1]
+		switch {
+		case strings.HasPrefix(status, "A"):
+			files = append(files, fileDiff{newPath: name})
+		case strings.HasPrefix(status, "D"):
+			files = append(files, fileDiff{oldPath: name})
+		default:
+			files = append(files, fileDiff{oldPath: name, newPath: name})
+		}
+	}
+	return files
+}
+
+// writeRevision writes a file, relative to the repository root, at a
+// revision under dir: ":" for the index and "" for the working tree. Files
+// that can't be read, such as submodules, are left out.
+func writeRevision(root, rev, name, dir string) error {
+	var content []byte
+	var err error
+	switch rev {
+	case "":
+		content, err = os.ReadFile(filepath.Join(root, filepath.FromSlash(name)))
+	case ":":
+		content, err = exec.Command("git", "-C", root, "show", ":"+name).Output()
+	default:
+		content, err = exec.Command("git", "-C", root, "show", rev+":"+name).Output()
+	}
+	if err != nil {
+		return nil
+	}
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(target, content, 0o644)
+}
+
+// Summary describes the changes in a sentence, e.g. "Adds 340 Go and 25
+// YAML code lines and 12 comment lines, removes 20 code lines, in 4 files"
+func (c *Changes) Summary() string {
+	if c.Total.Files == 0 {
+		return "No changes"
+	}
+	// Up to three languages by name, then the rest together
+	var byLang []string
+	named := 0
+	for _, lang := range c.Languages {
+		if lang.Added.Code == 0 {
+			continue
+		}
+		if len(byLang) == 3 {
+			byLang = append(byLang, fmt.Sprintf("%d other", c.Total.Added.Code-named))
+			break
+		}
+		byLang = append(byLang, fmt.Sprintf("%d %s", lang.Added.Code, lang.Name))
+		named += lang.Added.Code
+	}
+
+	var clauses []string
+	if added := lineClause(joinList(byLang), c.Total.Added.Code, c.Total.Added.Comment); added != "" {
+		clauses = append(clauses, "adds "+added)
+	}
+	removedCode := ""
+	if c.Total.Removed.Code > 0 {
+		removedCode = strconv.Itoa(c.Total.Removed.Code)
+	}
+	if removed := lineClause(removedCode, c.Total.Removed.Code, c.Total.Removed.Comment); removed != "" {
+		clauses = append(clauses, "removes "+removed)
+	}
+	if len(clauses) == 0 {
+		clauses = append(clauses, "only changes blank lines")
+	}
+
+	summary := strings.Join(clauses, ", ")
+	return strings.ToUpper(summary[:1]) + summary[1:] + fmt.Sprintf(", in %d %s", c.Total.Files, plural("file", c.Total.Files == 1))
+}
+
+// lineClause joins code and comment line counts, e.g. "20 code lines and 3
+// comment lines", leaving out what is empty or zero. code is the code line
+// count as it is to be written, such as "340 Go and 25 YAML", and codeLines
+// its total.
+func lineClause(code string, codeLines, comments int) string {
+	var parts []string
+	if code != "" {
+		parts = append(parts, code+" code "+plural("line", codeLines == 1))
+	}
+	if comments > 0 {
+		parts = append(parts, fmt.Sprintf("%d comment %s", comments, plural("line", comments == 1)))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// plural adds an s to word unless one
+func plural(word string, one bool) string {
+	if one {
+		return word
+	}
+	return word + "s"
+}
+
+// joinList joins items as "a, b and c"
+func joinList(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
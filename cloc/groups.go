@@ -0,0 +1,71 @@
+package cloc
+
+import "sort"
+
+// Group returns a copy of the result with the languages of each group merged
+// into one language named after the group. Merged languages are listed in
+// Members and keep their own Files entry, so they can still be shown on their
+// own. A language in more than one group joins the first by name, and groups
+// without any language in the result are left out. Groups the result already
+// has, such as separated submodules, are kept. Group names should not match
+// a language name.
+func (r *Result) Group(groups map[string][]string) *Result {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	groupOf := make(map[string]string)
+	for _, name := range names {
+		for _, lang := range groups[name] {
+			if _, ok := groupOf[lang]; !ok {
+				groupOf[lang] = name
+			}
+		}
+	}
+
+	grouped := &Result{
+		Files:      make(map[string][]FileInfo, len(r.Files)),
+		Total:      r.Total,
+		Members:    make(map[string][]LanguageStats),
+		OwnersFile: r.OwnersFile,
+	}
+	for group, members := range r.Members {
+		grouped.Members[group] = members
+	}
+	for lang, files := range r.Files {
+		grouped.Files[lang] = files
+	}
+
+	merged := make(map[string]*LanguageStats)
+	for _, lang := range r.Languages {
+		name, ok := groupOf[lang.Name]
+		if !ok {
+			grouped.Languages = append(grouped.Languages, lang)
+			continue
+		}
+		stats, ok := merged[name]
+		if !ok {
+			grouped.Languages = append(grouped.Languages, LanguageStats{Name: name})
+			stats = &LanguageStats{Name: name}
+			merged[name] = stats
+			grouped.Files[name] = nil
+		}
+		stats.Files += lang.Files
+		stats.Blank += lang.Blank
+		stats.Comment += lang.Comment
+		stats.Code += lang.Code
+		grouped.Members[name] = append(grouped.Members[name], lang)
+		grouped.Files[name] = append(grouped.Files[name], r.Files[lang.Name]...)
+	}
+
+	for i, lang := range grouped.Languages {
+		if stats, ok := merged[lang.Name]; ok {
+			grouped.Languages[i] = *stats
+			files := grouped.Files[lang.Name]
+			sort.SliceStable(files, func(i, j int) bool { return files[i].Code > files[j].Code })
+		}
+	}
+	return grouped
+}
@@ -0,0 +1,148 @@
+package cloc
+
+import "strings"
+
+// LineKind classifies a single source line the same way cloc counts it
+type LineKind int
+
+const (
+	LineCode LineKind = iota
+	LineComment
+	LineBlank
+)
+
+// commentSyntax describes how comments are written in a language
+type commentSyntax struct {
+	line       []string
+	blockStart string
+	blockEnd   string
+}
+
+var (
+	cStyle    = commentSyntax{line: []string{"//"}, blockStart: "/*", blockEnd: "*/"}
+	hashStyle = commentSyntax{line: []string{"#"}}
+	xmlStyle  = commentSyntax{blockStart: "<!--", blockEnd: "-->"}
+)
+
+// commentSyntaxes maps cloc language names to their comment syntax
+var commentSyntaxes = map[string]commentSyntax{
+	"C":                  cStyle,
+	"C++":                cStyle,
+	"C/C++ Header":       cStyle,
+	"C#":                 cStyle,
+	"Dart":               cStyle,
+	"Go":                 cStyle,
+	"Groovy":             cStyle,
+	"Java":               cStyle,
+	"JavaScript":         cStyle,
+	"JSX":                cStyle,
+	"Kotlin":             cStyle,
+	"Objective-C":        cStyle,
+	"Protocol Buffers":   cStyle,
+	"Rust":               cStyle,
+	"Scala":              cStyle,
+	"Solidity":           cStyle,
+	"Swift":              cStyle,
+	"TypeScript":         cStyle,
+	"Zig":                cStyle,
+	"Sass":               cStyle,
+	"SCSS":               cStyle,
+	"LESS":               cStyle,
+	"CSS":                {blockStart: "/*", blockEnd: "*/"},
+	"PHP":                {line: []string{"//", "#"}, blockStart: "/*", blockEnd: "*/"},
+	"Nix":                {line: []string{"#"}, blockStart: "/*", blockEnd: "*/"},
+	"Python":             hashStyle,
+	"Perl":               hashStyle,
+	"Bourne Shell":       hashStyle,
+	"Bourne Again Shell": hashStyle,
+	"zsh":                hashStyle,
+	"Fish Shell":         hashStyle,
+	"make":               hashStyle,
+	"CMake":              hashStyle,
+	"Dockerfile":         hashStyle,
+	"YAML":               hashStyle,
+	"TOML":               hashStyle,
+	"R":                  hashStyle,
+	"Elixir":             hashStyle,
+	"Tcl/Tk":             hashStyle,
+	"Starlark":           hashStyle,
+	"Ruby":               {line: []string{"#"}, blockStart: "=begin", blockEnd: "=end"},
+	"PowerShell":         {line: []string{"#"}, blockStart: "<#", blockEnd: "#>"},
+	"SQL":                {line: []string{"--"}, blockStart: "/*", blockEnd: "*/"},
+	"Haskell":            {line: []string{"--"}, blockStart: "{-", blockEnd: "-}"},
+	"Lua":                {line: []string{"--"}, blockStart: "--[[", blockEnd: "]]"},
+	"Ada":                {line: []string{"--"}},
+	"Lisp":               {line: []string{";"}},
+	"Clojure":            {line: []string{";"}},
+	"Scheme":             {line: []string{";"}},
+	"Assembly":           {line: []string{";", "#", "//"}},
+	"Erlang":             {line: []string{"%"}},
+	"TeX":                {line: []string{"%"}},
+	"MATLAB":             {line: []string{"%"}, blockStart: "%{", blockEnd: "%}"},
+	"Fortran 90":         {line: []string{"!"}},
+	"Vim Script":         {line: []string{"\""}},
+	"Visual Basic .NET":  {line: []string{"'"}},
+	"HTML":               xmlStyle,
+	"XML":                xmlStyle,
+	"XSD":                xmlStyle,
+	"Markdown":           xmlStyle,
+	"Vuejs Component":    {line: []string{"//"}, blockStart: "<!--", blockEnd: "-->"},
+}
+
+// ClassifyLines classifies each line of a file as code, comment or blank.
+// Lines mixing code and comments count as code, matching cloc.
+func ClassifyLines(language string, lines []string) []LineKind {
+	syntax := commentSyntaxes[language]
+	kinds := make([]LineKind, len(lines))
+	inBlock := false
+	for i, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			kinds[i] = LineBlank
+		case syntax.hasCode(line, &inBlock):
+			kinds[i] = LineCode
+		default:
+			kinds[i] = LineComment
+		}
+	}
+	return kinds
+}
+
+// hasCode reports whether a line contains anything outside comments,
+// tracking whether a block comment is still open at the end of the line
+func (s commentSyntax) hasCode(line string, inBlock *bool) bool {
+	for {
+		line = strings.TrimSpace(line)
+		if *inBlock {
+			end := strings.Index(line, s.blockEnd)
+			if end < 0 {
+				return false
+			}
+			*inBlock = false
+			line = line[end+len(s.blockEnd):]
+			continue
+		}
+		if line == "" {
+			return false
+		}
+		if s.blockStart != "" && strings.HasPrefix(line, s.blockStart) {
+			*inBlock = true
+			line = line[len(s.blockStart):]
+			continue
+		}
+		for _, prefix := range s.line {
+			if strings.HasPrefix(line, prefix) {
+				return false
+			}
+		}
+
+		// Code line, but a block comment may open after the code
+		if s.blockStart != "" {
+			start := strings.LastIndex(line, s.blockStart)
+			if start >= 0 && strings.LastIndex(line, s.blockEnd) < start {
+				*inBlock = true
+			}
+		}
+		return true
+	}
+}
@@ -0,0 +1,124 @@
+package cloc
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LanguageMetrics contains statistics derived from a language's files
+type LanguageMetrics struct {
+	AvgCode      float64 // Code lines per file
+	CommentRatio float64 // Comment lines as a fraction of code and comment lines
+	Share        float64 // Fraction of all code lines
+	MaxLines     int     // Lines in the largest file
+	MedianLines  float64 // Median lines per file
+	Bytes        int64   // Total size of the files
+	TestFiles    int     // Files marked as tests
+	TestCode     int     // Code lines in test files
+	TestRatio    float64 // Test code lines per non-test code line
+	AgeCode      []int   // Code lines by AgeBuckets, nil without file ages
+	Active       float64 // Fraction of the dated code changed within a year
+}
+
+// Metrics computes derived statistics for every language in the result,
+// including the members of groups
+func (r *Result) Metrics() map[string]LanguageMetrics {
+	metrics := make(map[string]LanguageMetrics, len(r.Languages))
+	for _, lang := range r.Languages {
+		metrics[lang.Name] = r.languageMetrics(lang)
+		for _, member := range r.Members[lang.Name] {
+			metrics[member.Name] = r.languageMetrics(member)
+		}
+	}
+	return metrics
+}
+
+// languageMetrics computes the derived statistics of one language
+func (r *Result) languageMetrics(lang LanguageStats) LanguageMetrics {
+	var lm LanguageMetrics
+	if lang.Files > 0 {
+		lm.AvgCode = float64(lang.Code) / float64(lang.Files)
+	}
+	if lang.Code+lang.Comment > 0 {
+		lm.CommentRatio = float64(lang.Comment) / float64(lang.Code+lang.Comment)
+	}
+	if r.Total.Code > 0 {
+		lm.Share = float64(lang.Code) / float64(r.Total.Code)
+	}
+
+	files := r.Files[lang.Name]
+	lines := make([]int, len(files))
+	for i, file := range files {
+		lines[i] = file.Blank + file.Comment + file.Code
+		lm.MaxLines = max(lm.MaxLines, lines[i])
+		lm.Bytes += file.Bytes
+		if file.Test {
+			lm.TestFiles++
+			lm.TestCode += file.Code
+		}
+	}
+	if prod := lang.Code - lm.TestCode; prod > 0 {
+		lm.TestRatio = float64(lm.TestCode) / float64(prod)
+	}
+	lm.AgeCode = AgeCode(files, time.Now())
+	lm.Active = ActiveShare(lm.AgeCode)
+	if len(lines) > 0 {
+		sort.Ints(lines)
+		mid := len(lines) / 2
+		lm.MedianLines = float64(lines[mid])
+		if len(lines)%2 == 0 {
+			lm.MedianLines = float64(lines[mid-1]+lines[mid]) / 2
+		}
+	}
+	return lm
+}
+
+// fillSizes sets the size of every file, from disk or from the git tree
+func fillSizes(r *Result, path string, isGit bool) {
+	var blobSizes map[string]int64
+	if isGit {
+		blobSizes = gitBlobSizes("", path)
+	}
+
+	for _, files := range r.Files {
+		for i := range files {
+			if isGit {
+				files[i].Bytes = blobSizes[strings.TrimPrefix(files[i].Path, "./")]
+			} else if stat, err := os.Stat(files[i].Path); err == nil {
+				files[i].Bytes = stat.Size()
+			}
+		}
+	}
+}
+
+// gitBlobSizes returns the size of every blob in the tree of a git ref, in
+// the repository at dir or the working directory if empty
+func gitBlobSizes(dir, ref string) map[string]int64 {
+	sizes := make(map[string]int64)
+	output, err := gitOutput("-C", dir, "ls-tree", "-r", "-l", ref)
+	if err != nil {
+		return sizes
+	}
+
+	// Lines look like "<mode> blob <hash> <size>\t<path>"
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		meta, path, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(meta)
+		if len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+		if size, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+			sizes[path] = size
+		}
+	}
+	return sizes
+}
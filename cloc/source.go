@@ -0,0 +1,17 @@
+package cloc
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ReadSource returns the contents of a scanned file. For git ref scans the
+// blob is read with git show rev:path instead of from the working tree.
+func ReadSource(path string, ref string, isGit bool) ([]byte, error) {
+	if !isGit {
+		return os.ReadFile(path)
+	}
+	cmd := exec.Command("git", "show", ref+":"+strings.TrimPrefix(path, "./"))
+	return cmd.Output()
+}
@@ -0,0 +1,318 @@
+package cloc
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// gitlinkMode is the tree entry mode of a submodule's pinned commit
+const gitlinkMode = "160000"
+
+// Submodule is a git submodule, or a repository nested in the scanned
+// directory without being registered as one
+type Submodule struct {
+	Name   string // Path relative to the scanned path, slash-separated
+	Path   string // Path prefix of its files as cloc reports them
+	Commit string // Pinned commit, or HEAD of a nested repository
+	Nested bool   // Not registered as a submodule
+	dir    string // Where it is checked out
+}
+
+// Label names the submodule with its short commit, e.g. "libfoo @ 1a2b3c4"
+func (s Submodule) Label() string {
+	commit := s.Commit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	if commit == "" {
+		commit = "no commits"
+	}
+	return s.Name + " @ " + commit
+}
+
+// contains reports whether a file path, as cloc reports it, is in the
+// submodule
+func (s Submodule) contains(path string, isGit bool) bool {
+	if isGit {
+		return strings.HasPrefix(strings.TrimPrefix(path, "./"), s.Path+"/")
+	}
+	return strings.HasPrefix(path, s.Path+string(filepath.Separator))
+}
+
+// SubmoduleLanguage names a language of a submodule's files when they are
+// shown apart from the parent's, e.g. "libfoo: Go"
+func SubmoduleLanguage(submodule, language string) string {
+	return submodule + ": " + language
+}
+
+// findSubmodules lists the submodules under path with their pinned commits.
+// For a directory, repositories nested in it that hold counted files are
+// listed too; a git ref's tree only records submodules, and is read from the
+// repository in the working directory, as cloc --git does.
+func findSubmodules(path string, isGit bool, files map[string][]FileInfo) []Submodule {
+	if isGit {
+		root := repoRoot(".")
+		output, err := gitOutput("ls-tree", "-r", path)
+		if err != nil {
+			return nil
+		}
+		var subs []Submodule
+		// Lines look like "160000 commit <hash>\t<path>"
+		for _, line := range strings.Split(string(output), "\n") {
+			meta, name, ok := strings.Cut(line, "\t")
+			fields := strings.Fields(meta)
+			if ok && len(fields) == 3 && fields[0] == gitlinkMode {
+				dir := filepath.Join(root, filepath.FromSlash(name))
+				subs = append(subs, Submodule{Name: name, Path: name, Commit: fields[2], dir: dir})
+			}
+		}
+		return subs
+	}
+
+	tree := openWorkTree(path)
+	// Lines look like "160000 <hash> <stage>\t<path>"
+	output, err := gitOutput("-C", tree.root, "ls-files", "-s", "--", tree.resolved)
+	if err != nil {
+		// Outside a repository any directory may hold one
+		return sortSubmodules(findNested(path, files, nil))
+	}
+	var subs []Submodule
+	known := make(map[string]bool)
+	for _, line := range strings.Split(string(output), "\n") {
+		meta, name, ok := strings.Cut(line, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 || fields[0] != gitlinkMode {
+			continue
+		}
+		dir := tree.filePath(name)
+		rel, err := filepath.Rel(path, dir)
+		if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		subs = append(subs, Submodule{Name: filepath.ToSlash(rel), Path: dir, Commit: fields[1], dir: dir})
+		known[dir] = true
+	}
+	return sortSubmodules(append(subs, findNested(path, files, known)...))
+}
+
+// findNested lists the repositories nested in path that hold counted files,
+// other than the known submodules, by looking for one in the directories
+// between path and each file rather than walking the whole tree. Nested
+// repositories have a .git directory, or a .git file when they are
+// worktrees; a repository nested in another is part of the outer one.
+func findNested(path string, files map[string][]FileInfo, known map[string]bool) []Submodule {
+	var subs []Submodule
+	isRepo := make(map[string]bool)
+	searched := make(map[string]bool)
+	for _, langFiles := range files {
+		for _, file := range langFiles {
+			fileDir := filepath.Dir(file.Path)
+			if searched[fileDir] {
+				continue
+			}
+			searched[fileDir] = true
+			rel, err := filepath.Rel(path, fileDir)
+			if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+				continue
+			}
+
+			dir := path
+			for _, part := range strings.Split(rel, string(filepath.Separator)) {
+				dir = filepath.Join(dir, part)
+				if known[dir] {
+					break
+				}
+				repo, ok := isRepo[dir]
+				if !ok {
+					_, err := os.Lstat(filepath.Join(dir, ".git"))
+					repo = err == nil
+					isRepo[dir] = repo
+					if repo {
+						subs = append(subs, nestedRepo(path, dir))
+					}
+				}
+				if repo {
+					break
+				}
+			}
+		}
+	}
+	return subs
+}
+
+// nestedRepo describes the repository at dir, nested in path
+func nestedRepo(path, dir string) Submodule {
+	rel, _ := filepath.Rel(path, dir)
+	sub := Submodule{Name: filepath.ToSlash(rel), Path: dir, Nested: true, dir: dir}
+	if output, err := gitOutput("-C", dir, "rev-parse", "HEAD"); err == nil {
+		sub.Commit = strings.TrimSpace(string(output))
+	}
+	return sub
+}
+
+// sortSubmodules sorts submodules by name
+func sortSubmodules(subs []Submodule) []Submodule {
+	sort.Slice(subs, func(i, j int) bool { return subs[i].Name < subs[j].Name })
+	return subs
+}
+
+// markSubmodules records the submodule each file belongs to
+func markSubmodules(r *Result, isGit bool) {
+	for _, files := range r.Files {
+		for i := range files {
+			for _, sub := range r.Submodules {
+				if sub.contains(files[i].Path, isGit) {
+					files[i].Submodule = sub.Name
+					break
+				}
+			}
+		}
+	}
+}
+
+// addSubmoduleFiles counts the submodules of a git ref at their pinned
+// commits, which cloc --git leaves out. Submodules that aren't checked out
+// with the commit available are skipped.
+func addSubmoduleFiles(r *Result) {
+	for _, sub := range r.Submodules {
+		dir := sub.dir
+		if _, err := gitOutput("-C", dir, "cat-file", "-e", sub.Commit+"^{commit}"); err != nil {
+			continue
+		}
+		files, err := clocGitFiles(dir, sub.Commit)
+		if err != nil {
+			continue
+		}
+		sizes := gitBlobSizes(dir, sub.Commit)
+		for _, file := range files {
+			file.Bytes = sizes[strings.TrimPrefix(file.Path, "./")]
+			file.Path = sub.Path + "/" + strings.TrimPrefix(file.Path, "./")
+			r.Files[file.Language] = append(r.Files[file.Language], file)
+			r.adjustLanguage(file, 1)
+		}
+	}
+
+	sort.SliceStable(r.Languages, func(i, j int) bool {
+		return r.Languages[i].Code > r.Languages[j].Code
+	})
+	for lang := range r.Files {
+		files := r.Files[lang]
+		sort.SliceStable(files, func(i, j int) bool { return files[i].Code > files[j].Code })
+	}
+}
+
+// clocGitFiles counts the files of a commit in the repository at dir
+func clocGitFiles(dir, commit string) ([]FileInfo, error) {
+	cmd := exec.Command("cloc", "--json", "--by-file", "--git", commit)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		// cloc prints nothing when it finds no files
+		return nil, nil
+	}
+
+	var rawResult map[string]json.RawMessage
+	if err := json.Unmarshal(output, &rawResult); err != nil {
+		return nil, err
+	}
+	var files []FileInfo
+	for key, value := range rawResult {
+		if key == "header" || key == "SUM" {
+			continue
+		}
+		var info FileInfo
+		if err := json.Unmarshal(value, &info); err != nil {
+			continue
+		}
+		info.Path = key
+		files = append(files, info)
+	}
+	return files, nil
+}
+
+// WithoutSubmodules returns a copy of the result without the files of any
+// submodule
+func (r *Result) WithoutSubmodules() *Result {
+	return r.FilterFiles(func(file FileInfo) bool { return file.Submodule == "" })
+}
+
+// SeparateSubmodules returns a copy of the result with the files of each
+// submodule moved out of their languages into a group of their own, named
+// by the submodule's Label. The group's members are its languages, named
+// by SubmoduleLanguage so they stay apart from the parent's.
+func (r *Result) SeparateSubmodules(subs []Submodule) *Result {
+	separated := &Result{
+		Files:      make(map[string][]FileInfo, len(r.Files)),
+		Total:      r.Total,
+		Members:    make(map[string][]LanguageStats, len(subs)),
+		OwnersFile: r.OwnersFile,
+	}
+
+	labels := make(map[string]string, len(subs))
+	for _, sub := range subs {
+		labels[sub.Name] = sub.Label()
+	}
+	groups := make(map[string]*LanguageStats)
+	members := make(map[string]map[string]*LanguageStats)
+	for _, lang := range r.Languages {
+		own := LanguageStats{Name: lang.Name}
+		var ownFiles []FileInfo
+		for _, file := range r.Files[lang.Name] {
+			label, ok := labels[file.Submodule]
+			if !ok {
+				ownFiles = append(ownFiles, file)
+				addFile(&own, file)
+				continue
+			}
+			if groups[label] == nil {
+				groups[label] = &LanguageStats{Name: label}
+				members[label] = make(map[string]*LanguageStats)
+			}
+			name := SubmoduleLanguage(file.Submodule, lang.Name)
+			member := members[label][name]
+			if member == nil {
+				member = &LanguageStats{Name: name}
+				members[label][name] = member
+			}
+			addFile(groups[label], file)
+			addFile(member, file)
+			separated.Files[label] = append(separated.Files[label], file)
+			separated.Files[name] = append(separated.Files[name], file)
+		}
+		if len(ownFiles) == 0 {
+			continue
+		}
+		separated.Languages = append(separated.Languages, own)
+		separated.Files[lang.Name] = ownFiles
+	}
+
+	for _, sub := range subs {
+		label := sub.Label()
+		group := groups[label]
+		if group == nil {
+			continue
+		}
+		separated.Languages = append(separated.Languages, *group)
+		for _, member := range members[label] {
+			separated.Members[label] = append(separated.Members[label], *member)
+		}
+		sort.Slice(separated.Members[label], func(i, j int) bool {
+			a, b := separated.Members[label][i], separated.Members[label][j]
+			if a.Code != b.Code {
+				return a.Code > b.Code
+			}
+			return a.Name < b.Name
+		})
+	}
+	for _, files := range separated.Files {
+		sort.SliceStable(files, func(i, j int) bool { return files[i].Code > files[j].Code })
+	}
+	return separated
+}
@@ -0,0 +1,76 @@
+package cloc
+
+// DefaultTestPatterns are the path globs, as for MatchGlob, that mark test
+// files in each language. Patterns under "*" apply to every language.
+var DefaultTestPatterns = map[string][]string{
+	"*":          {"**/test/", "**/tests/", "**/__tests__/", "**/spec/"},
+	"C#":         {"*Tests.cs", "*Test.cs"},
+	"Dart":       {"*_test.dart"},
+	"Elixir":     {"*_test.exs"},
+	"Go":         {"*_test.go"},
+	"Java":       {"**/src/test/", "*Test.java", "*Tests.java"},
+	"JavaScript": {"*.test.js", "*.spec.js", "*.test.mjs", "*.spec.mjs"},
+	"JSX":        {"*.test.jsx", "*.spec.jsx"},
+	"Kotlin":     {"**/src/test/", "*Test.kt"},
+	"PHP":        {"*Test.php"},
+	"Python":     {"test_*.py", "*_test.py", "conftest.py"},
+	"Ruby":       {"*_spec.rb", "*_test.rb"},
+	"Scala":      {"**/src/test/", "*Spec.scala", "*Test.scala"},
+	"Swift":      {"*Tests.swift"},
+	"TypeScript": {"*.test.ts", "*.spec.ts", "*.test.tsx", "*.spec.tsx"},
+}
+
+// TestMatcher classifies files as tests by per-language path globs
+type TestMatcher map[string][]string
+
+// NewTestMatcher returns the default patterns, with those of any language in
+// overrides replaced
+func NewTestMatcher(overrides map[string][]string) TestMatcher {
+	t := make(TestMatcher, len(DefaultTestPatterns)+len(overrides))
+	for lang, patterns := range DefaultTestPatterns {
+		t[lang] = patterns
+	}
+	for lang, patterns := range overrides {
+		t[lang] = patterns
+	}
+	return t
+}
+
+// IsTest reports whether a file of the language at the slash-separated
+// relative path is a test
+func (t TestMatcher) IsTest(language, rel string) bool {
+	for _, patterns := range [][]string{t[language], t["*"]} {
+		for _, pattern := range patterns {
+			if MatchGlob(pattern, rel) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FilterFiles returns a copy of the result with only the files keep accepts,
+// and the language stats and total recomputed from them
+func (r *Result) FilterFiles(keep func(FileInfo) bool) *Result {
+	filtered := &Result{Files: make(map[string][]FileInfo), OwnersFile: r.OwnersFile}
+	for _, lang := range r.Languages {
+		stats := LanguageStats{Name: lang.Name}
+		var files []FileInfo
+		for _, file := range r.Files[lang.Name] {
+			if keep(file) {
+				files = append(files, file)
+				addFile(&stats, file)
+			}
+		}
+		if len(files) == 0 {
+			continue
+		}
+		filtered.Languages = append(filtered.Languages, stats)
+		filtered.Files[lang.Name] = files
+		filtered.Total.Files += stats.Files
+		filtered.Total.Blank += stats.Blank
+		filtered.Total.Comment += stats.Comment
+		filtered.Total.Code += stats.Code
+	}
+	return filtered
+}
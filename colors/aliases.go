@@ -0,0 +1,177 @@
+package colors
+
+import "strings"
+
+// Aliases maps language names used by cloc, tokei and scc to the GitHub
+// linguist names that LanguageColors is keyed by. Names linguist doesn't
+// know map to their closest relative, e.g. header files to their language
+// and XML dialects to XML.
+var Aliases = map[string]string{
+	// cloc
+	"AnsProlog":                      "Answer Set Programming",
+	"Ant":                            "Ant Build System",
+	"ANTLR Grammar":                  "ANTLR",
+	"Apex Class":                     "Apex",
+	"Apex Trigger":                   "Apex",
+	"Arduino Sketch":                 "C++",
+	"ArkTs":                          "TypeScript",
+	"ASP":                            "Classic ASP",
+	"awk":                            "Awk",
+	"Bazel":                          "Starlark",
+	"BizTalk Orchestration":          "XML",
+	"BizTalk Pipeline":               "XML",
+	"Bourne Again Shell":             "Shell",
+	"Bourne Shell":                   "Shell",
+	"BrightScript":                   "Brightscript",
+	"builder":                        "Ruby",
+	"C Shell":                        "Shell",
+	"C# Designer":                    "C#",
+	"C/C++ Header":                   "C",
+	"Cake Build Script":              "C#",
+	"ClojureC":                       "Clojure",
+	"ClojureScript":                  "Clojure",
+	"ColdFusion CFScript":            "ColdFusion",
+	"Containerfile":                  "Dockerfile",
+	"Coq":                            "Rocq Prover",
+	"Cucumber":                       "Gherkin",
+	"CUDA":                           "Cuda",
+	"Delphi Form":                    "Pascal",
+	"dhall":                          "Dhall",
+	"diff":                           "Diff",
+	"DITA":                           "XML",
+	"DOS Batch":                      "Batchfile",
+	"DTD":                            "XML",
+	"dtrace":                         "DTrace",
+	"ECPP":                           "HTML",
+	"EEx":                            "Elixir",
+	"Elixir Script":                  "Elixir",
+	"Embedded Crystal":               "HTML+ECR",
+	"ERB":                            "HTML+ERB",
+	"Expect":                         "Tcl",
+	"F# Script":                      "F#",
+	"Fish Shell":                     "Shell",
+	"Flatbuffers":                    "FlatBuffers",
+	"Fortran 77":                     "Fortran",
+	"Fortran 90":                     "Fortran Free Form",
+	"Fortran 95":                     "Fortran Free Form",
+	"Freemarker Template":            "FreeMarker",
+	"FXML":                           "XML",
+	"Glade":                          "XML",
+	"Glimmer JavaScript":             "Glimmer JS",
+	"Glimmer TypeScript":             "Glimmer TS",
+	"Godot Scene":                    "Godot Resource",
+	"Godot Shaders":                  "GDShader",
+	"Grails":                         "Groovy",
+	"Hoon":                           "hoon",
+	"HTML EEx":                       "Elixir",
+	"Igor Pro":                       "IGOR Pro",
+	"JavaServer Faces":               "Java Server Pages",
+	"Jinja Template":                 "Jinja",
+	"JSP":                            "Java Server Pages",
+	"JSX":                            "JavaScript",
+	"Korn Shell":                     "Shell",
+	"LESS":                           "Less",
+	"lex":                            "Lex",
+	"liquid":                         "Liquid",
+	"Lisp":                           "Common Lisp",
+	"Literate Idris":                 "Idris",
+	"LLVM IR":                        "LLVM",
+	"m4":                             "M4",
+	"make":                           "Makefile",
+	"Mathematica":                    "Wolfram Language",
+	"Maven":                          "XML",
+	"Modula3":                        "Modula-3",
+	"MSBuild script":                 "XML",
+	"MXML":                           "XML",
+	"NAnt script":                    "XML",
+	"Oracle Forms":                   "PLSQL",
+	"Oracle PL/SQL":                  "PLSQL",
+	"Oracle Reports":                 "PLSQL",
+	"Pascal/Pawn":                    "Pascal",
+	"Pascal/Puppet":                  "Pascal",
+	"PHP/Pascal":                     "PHP",
+	"Pig Latin":                      "PigLatin",
+	"PO File":                        "Gettext Catalog",
+	"Prisma Schema":                  "Prisma",
+	"ProGuard":                       "Proguard",
+	"Properties":                     "Java Properties",
+	"Protocol Buffers":               "Protocol Buffer",
+	"Qt":                             "XML",
+	"Qt Linguist":                    "XML",
+	"Qt Project":                     "QMake",
+	"Raku/Prolog":                    "Raku",
+	"RapydScript":                    "Python",
+	"Razor":                          "HTML+Razor",
+	"ReasonML":                       "Reason",
+	"Rexx":                           "REXX",
+	"Ruby HTML":                      "HTML+ERB",
+	"Softbridge Basic":               "BASIC",
+	"SQL Data":                       "SQL",
+	"SQL Stored Procedure":           "SQL",
+	"Tcl/Tk":                         "Tcl",
+	"Templ":                          "templ",
+	"TITAN Project File Information": "XML",
+	"Titanium Style Sheet":           "CSS",
+	"TLA+":                           "TLA",
+	"Unity-Prefab":                   "Unity3D Asset",
+	"Vala Header":                    "Vala",
+	"Verilog-SystemVerilog":          "SystemVerilog",
+	"vim script":                     "Vim Script",
+	"Visual Basic":                   "Visual Basic 6.0",
+	"Visual Basic Script":            "VBScript",
+	"Visual Fox Pro":                 "xBase",
+	"Visualforce Component":          "HTML",
+	"Visualforce Page":               "HTML",
+	"Vuejs Component":                "Vue",
+	"Windows Message File":           "Win32 Message File",
+	"WiX include":                    "XML",
+	"WiX source":                     "XML",
+	"WiX string localization":        "XML",
+	"WXML":                           "HTML",
+	"WXSS":                           "CSS",
+	"XAML":                           "XML",
+	"xBase Header":                   "xBase",
+	"XHTML":                          "HTML",
+	"XMI":                            "XML",
+	"XSD":                            "XML",
+	"yacc":                           "Yacc",
+	"zsh":                            "Shell",
+
+	// tokei and scc
+	"BASH":                          "Shell",
+	"Bash":                          "Shell",
+	"Batch":                         "Batchfile",
+	"C Header":                      "C",
+	"C++ Header":                    "C++",
+	"Cpp":                           "C++",
+	"CShell":                        "Shell",
+	"Fish":                          "Shell",
+	"Gherkin Specification":         "Gherkin",
+	"JavaServer Pages":              "Java Server Pages",
+	"Jupyter":                       "Jupyter Notebook",
+	"MSBuild":                       "XML",
+	"Powershell":                    "PowerShell",
+	"Rakefile":                      "Ruby",
+	"Sh":                            "Shell",
+	"Systemverilog":                 "SystemVerilog",
+	"TypeScript Typings":            "TypeScript",
+	"Visual Basic for Applications": "VBA",
+	"VimL":                          "Vim Script",
+	"Zsh":                           "Shell",
+}
+
+// LinguistName returns the linguist name for a language name from any
+// supported counter, or the name unchanged if it has no alias. Linguist's
+// own aliases, such as "golang", are also recognized.
+func LinguistName(language string) string {
+	if _, ok := Languages[language]; ok {
+		return language
+	}
+	if name, ok := Aliases[language]; ok {
+		return name
+	}
+	if name, ok := linguistAliases[strings.ToLower(language)]; ok {
+		return name
+	}
+	return language
+}
@@ -0,0 +1,115 @@
+package colors
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+)
+
+// unknown lists the cloc languages linguist has no entry for, under their
+// own name or an alias, so GetColor derives their color from their name
+var unknown = map[string]bool{
+	"ADSO/IDSM":                 true,
+	"AMPLE":                     true,
+	"Arturo":                    true,
+	"CCS":                       true,
+	"CoCoA 5":                   true,
+	"DAL":                       true,
+	"Derw":                      true,
+	"DIET":                      true,
+	"DOORS Extension Language":  true,
+	"Drools":                    true,
+	"Finite State Language":     true,
+	"Focus":                     true,
+	"Gencat NLS":                true,
+	"InstallShield":             true,
+	"IPL":                       true,
+	"Jam":                       true,
+	"Juniper Junos":             true,
+	"Kermit":                    true,
+	"LiveLink OScript":          true,
+	"Mojom":                     true,
+	"NASTRAN DMAP":              true,
+	"Pest":                      true,
+	"PL/I":                      true,
+	"PL/M":                      true,
+	"PRQL":                      true,
+	"SKILL":                     true,
+	"SparForte":                 true,
+	"Specman e":                 true,
+	"TableGen":                  true,
+	"TNSDL":                     true,
+	"tspeg":                     true,
+	"TTCN":                      true,
+	"Umka":                      true,
+	"Windows Module Definition": true,
+	"Windows Resource File":     true,
+	"X++":                       true,
+	"Yarn":                      true,
+}
+
+// clocLanguages reads the language names cloc reports from testdata
+func clocLanguages(t *testing.T) []string {
+	t.Helper()
+	f, err := os.Open("testdata/cloc-languages.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			names = append(names, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+// checkColor fails unless the language resolves to a linguist color, or
+// gets the color derived from its name because linguist gives its language
+// none or it is listed in unknown
+func checkColor(t *testing.T, name string) {
+	t.Helper()
+	_, known := Languages[LinguistName(name)]
+	if unknown[name] && known {
+		t.Errorf("%q is linguist's %q; remove it from unknown", name, LinguistName(name))
+	}
+	if color, ok := linguistColor(name); ok && color != "" {
+		return
+	}
+	if !known && !unknown[name] {
+		t.Errorf("%q has no linguist equivalent; alias it or add it to unknown", name)
+	}
+	if got, want := GetColor(name), forBackground(HashColor(name)); got != want {
+		t.Errorf("GetColor(%q) = %s, want the derived %s", name, got, want)
+	}
+}
+
+func TestAliasesResolveToColors(t *testing.T) {
+	for name, linguist := range Aliases {
+		if _, ok := Languages[linguist]; !ok {
+			t.Errorf("alias %q: %q is not a linguist language", name, linguist)
+			continue
+		}
+		checkColor(t, name)
+	}
+}
+
+func TestClocLanguagesHaveColors(t *testing.T) {
+	reported := make(map[string]bool)
+	for _, name := range clocLanguages(t) {
+		reported[name] = true
+		checkColor(t, name)
+	}
+	for name := range unknown {
+		if !reported[name] {
+			t.Errorf("unknown %q is not a language cloc reports", name)
+		}
+	}
+}
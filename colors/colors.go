@@ -1,3 +1,5 @@
+// Code generated by linguistgen from languages.yml; DO NOT EDIT.
+
 package colors
 
 // LanguageColors maps programming language names to their GitHub colors (hex format)
@@ -45,6 +47,8 @@ var LanguageColors = map[string]string{
 	"AutoIt":                          "#1C3552",
 	"Avro IDL":                        "#0040FF",
 	"Awk":                             "#c30e9b",
+	"B (Formal Method)":               "#8aa8c5",
+	"B4X":                             "#00e4ff",
 	"Ballerina":                       "#FF5000",
 	"BASIC":                           "#ff0000",
 	"Batchfile":                       "#C1F12E",
@@ -174,6 +178,7 @@ var LanguageColors = map[string]string{
 	"Filebench WML":                   "#F6B900",
 	"FIRRTL":                          "#2f632f",
 	"fish":                            "#4aae47",
+	"FlatBuffers":                     "#ed284a",
 	"Flix":                            "#d44a45",
 	"Fluent":                          "#ffcc33",
 	"FLUX":                            "#88ccff",
@@ -328,6 +333,7 @@ var LanguageColors = map[string]string{
 	"LigoLANG":                        "#0e74ff",
 	"LilyPond":                        "#9ccc7c",
 	"Liquid":                          "#67b8de",
+	"Liquidsoap":                      "#990066",
 	"Literate Agda":                   "#315665",
 	"Literate CoffeeScript":           "#244776",
 	"Literate Haskell":                "#5e5086",
@@ -358,6 +364,7 @@ var LanguageColors = map[string]string{
 	"Mermaid":                         "#ff3670",
 	"Meson":                           "#007800",
 	"Metal":                           "#8f14e9",
+	"MeTTa":                           "#6a5acd",
 	"MiniYAML":                        "#ff1111",
 	"MiniZinc":                        "#06a9e6",
 	"Mint":                            "#02b046",
@@ -662,14 +669,3 @@ var LanguageColors = map[string]string{
 	"Zimpl":                           "#d67711",
 	"Zmodel":                          "#ff7100",
 }
-
-// DefaultColor is used when a language doesn't have a defined color
-const DefaultColor = "#808080"
-
-// GetColor returns the color for a language, or the default color if not found
-func GetColor(language string) string {
-	if color, ok := LanguageColors[language]; ok {
-		return color
-	}
-	return DefaultColor
-}
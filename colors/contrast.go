@@ -0,0 +1,176 @@
+package colors
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MinContrast is the contrast ratio language colors are adjusted to meet
+// against the background: WCAG 2.1's 3:1 for graphical objects (1.4.11)
+const MinContrast = 3.0
+
+var (
+	mu         sync.Mutex
+	background *rgb
+	adjusted   = map[string]string{}
+)
+
+// rgb is a color with channels from 0 to 1
+type rgb struct{ r, g, b float64 }
+
+// SetBackground sets the terminal background that GetColor adjusts colors
+// against. An empty or invalid color turns adjustment off.
+func SetBackground(hex string) {
+	mu.Lock()
+	defer mu.Unlock()
+	background = nil
+	if c, ok := parseHex(hex); ok {
+		background = &c
+	}
+	adjusted = map[string]string{}
+}
+
+// forBackground returns the color with its lightness moved away from the
+// background until it meets MinContrast, or unchanged if it already does
+func forBackground(hex string) string {
+	mu.Lock()
+	defer mu.Unlock()
+	if background == nil {
+		return hex
+	}
+	if c, ok := adjusted[hex]; ok {
+		return c
+	}
+
+	result := hex
+	if c, ok := parseHex(hex); ok && contrast(c, *background) < MinContrast {
+		h, s, l := c.hsl()
+		step := 0.02
+		if background.luminance() > 0.5 {
+			step = -step
+		}
+		for l > 0 && l < 1 && contrast(c, *background) < MinContrast {
+			l = math.Min(math.Max(l+step, 0), 1)
+			c = fromHSL(h, s, l)
+		}
+		result = c.hex()
+	}
+	adjusted[hex] = result
+	return result
+}
+
+// HashColor derives a stable color from a name, so languages without a
+// linguist color are still told apart
+func HashColor(name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	sum := h.Sum32()
+	hue := float64(sum%360) / 360
+	// Vary saturation and lightness a little so close hues still differ
+	sat := 0.55 + float64(sum>>9%20)/100
+	light := 0.5 + float64(sum>>17%15)/100
+	return fromHSL(hue, sat, light).hex()
+}
+
+func parseHex(hex string) (rgb, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return rgb{}, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return rgb{}, false
+	}
+	return rgb{float64(v>>16&0xff) / 255, float64(v>>8&0xff) / 255, float64(v&0xff) / 255}, true
+}
+
+func (c rgb) hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", int(math.Round(c.r*255)), int(math.Round(c.g*255)), int(math.Round(c.b*255)))
+}
+
+// luminance returns the WCAG relative luminance
+func (c rgb) luminance() float64 {
+	channel := func(v float64) float64 {
+		if v <= 0.03928 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.r) + 0.7152*channel(c.g) + 0.0722*channel(c.b)
+}
+
+// contrast returns the WCAG contrast ratio between two colors
+func contrast(a, b rgb) float64 {
+	la, lb := a.luminance(), b.luminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+func (c rgb) hsl() (h, s, l float64) {
+	maxC := math.Max(c.r, math.Max(c.g, c.b))
+	minC := math.Min(c.r, math.Min(c.g, c.b))
+	l = (maxC + minC) / 2
+	if maxC == minC {
+		return 0, 0, l
+	}
+
+	d := maxC - minC
+	if l > 0.5 {
+		s = d / (2 - maxC - minC)
+	} else {
+		s = d / (maxC + minC)
+	}
+	switch maxC {
+	case c.r:
+		h = (c.g - c.b) / d
+		if c.g < c.b {
+			h += 6
+		}
+	case c.g:
+		h = (c.b-c.r)/d + 2
+	default:
+		h = (c.r-c.g)/d + 4
+	}
+	return h / 6, s, l
+}
+
+func fromHSL(h, s, l float64) rgb {
+	if s == 0 {
+		return rgb{l, l, l}
+	}
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	hue := func(t float64) float64 {
+		switch {
+		case t < 0:
+			t++
+		case t > 1:
+			t--
+		}
+		switch {
+		case t < 1.0/6:
+			return p + (q-p)*6*t
+		case t < 0.5:
+			return q
+		case t < 2.0/3:
+			return p + (q-p)*(2.0/3-t)*6
+		default:
+			return p
+		}
+	}
+	return rgb{hue(h + 1.0/3), hue(h), hue(h - 1.0/3)}
+}
@@ -0,0 +1,91 @@
+// Command linguistgen generates the colors package tables from a copy of
+// GitHub linguist's languages.yml. It is run by go generate in the colors
+// package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// language is the subset of a languages.yml entry that gloc uses
+type language struct {
+	Type       string   `yaml:"type"`
+	Color      string   `yaml:"color"`
+	Extensions []string `yaml:"extensions"`
+	Aliases    []string `yaml:"aliases"`
+}
+
+const header = "// Code generated by linguistgen from languages.yml; DO NOT EDIT.\n\npackage colors\n\n"
+
+func main() {
+	data, err := os.ReadFile("languages.yml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var languages map[string]language
+	if err := yaml.Unmarshal(data, &languages); err != nil {
+		log.Fatalf("parsing languages.yml: %v", err)
+	}
+
+	// Sort case-insensitively, as linguist does
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return strings.ToLower(names[i]) < strings.ToLower(names[j])
+	})
+
+	var colors bytes.Buffer
+	colors.WriteString(header)
+	colors.WriteString("// LanguageColors maps programming language names to their GitHub colors (hex format)\n")
+	colors.WriteString("var LanguageColors = map[string]string{\n")
+	for _, name := range names {
+		if color := languages[name].Color; color != "" {
+			fmt.Fprintf(&colors, "%q: %q,\n", name, color)
+		}
+	}
+	colors.WriteString("}\n")
+
+	var meta bytes.Buffer
+	meta.WriteString(header)
+	meta.WriteString("// Languages maps linguist language names to their metadata\n")
+	meta.WriteString("var Languages = map[string]Language{\n")
+	for _, name := range names {
+		lang := languages[name]
+		var fields []string
+		if lang.Type != "" {
+			fields = append(fields, fmt.Sprintf("Type: %q", lang.Type))
+		}
+		if len(lang.Extensions) > 0 {
+			fields = append(fields, fmt.Sprintf("Extensions: %#v", lang.Extensions))
+		}
+		if len(lang.Aliases) > 0 {
+			fields = append(fields, fmt.Sprintf("Aliases: %#v", lang.Aliases))
+		}
+		fmt.Fprintf(&meta, "%q: {%s},\n", name, strings.Join(fields, ", "))
+	}
+	meta.WriteString("}\n")
+
+	write("colors.go", colors.Bytes())
+	write("linguist.go", meta.Bytes())
+}
+
+// write formats Go source and writes it to path
+func write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
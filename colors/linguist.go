@@ -0,0 +1,811 @@
+// Code generated by linguistgen from languages.yml; DO NOT EDIT.
+
+package colors
+
+// Languages maps linguist language names to their metadata
+var Languages = map[string]Language{
+	"1C Enterprise":                      {Type: "programming", Extensions: []string{".bsl", ".os"}},
+	"2-Dimensional Array":                {Type: "data", Extensions: []string{".2da"}},
+	"4D":                                 {Type: "programming", Extensions: []string{".4dm"}},
+	"ABAP":                               {Type: "programming", Extensions: []string{".abap"}},
+	"ABAP CDS":                           {Type: "programming", Extensions: []string{".asddls"}},
+	"ABNF":                               {Type: "data", Extensions: []string{".abnf"}},
+	"ActionScript":                       {Type: "programming", Extensions: []string{".as"}, Aliases: []string{"actionscript 3", "actionscript3", "as3"}},
+	"Ada":                                {Type: "programming", Extensions: []string{".adb", ".ada", ".ads"}, Aliases: []string{"ada95", "ada2005"}},
+	"Adblock Filter List":                {Type: "data", Extensions: []string{".txt"}, Aliases: []string{"ad block filters", "ad block", "adb", "adblock"}},
+	"Adobe Font Metrics":                 {Type: "data", Extensions: []string{".afm"}, Aliases: []string{"acfm", "adobe composite font metrics", "adobe multiple font metrics", "amfm"}},
+	"Agda":                               {Type: "programming", Extensions: []string{".agda"}},
+	"AGS Script":                         {Type: "programming", Extensions: []string{".asc", ".ash"}, Aliases: []string{"ags"}},
+	"AIDL":                               {Type: "programming", Extensions: []string{".aidl"}},
+	"Aiken":                              {Type: "programming", Extensions: []string{".ak"}},
+	"AL":                                 {Type: "programming", Extensions: []string{".al"}},
+	"ALGOL":                              {Type: "programming", Extensions: []string{".alg"}},
+	"Alloy":                              {Type: "programming", Extensions: []string{".als"}},
+	"Alpine Abuild":                      {Type: "programming", Aliases: []string{"abuild", "apkbuild"}},
+	"Altium Designer":                    {Type: "data", Extensions: []string{".OutJob", ".PcbDoc", ".PrjPCB", ".SchDoc"}, Aliases: []string{"altium"}},
+	"AMPL":                               {Type: "programming", Extensions: []string{".ampl", ".mod"}},
+	"AngelScript":                        {Type: "programming", Extensions: []string{".as", ".angelscript"}},
+	"Answer Set Programming":             {Type: "programming", Extensions: []string{".lp"}},
+	"Ant Build System":                   {Type: "data"},
+	"Antlers":                            {Type: "markup", Extensions: []string{".antlers.html", ".antlers.php", ".antlers.xml"}},
+	"ANTLR":                              {Type: "programming", Extensions: []string{".g4"}},
+	"ApacheConf":                         {Type: "data", Extensions: []string{".apacheconf", ".vhost"}, Aliases: []string{"aconf", "apache"}},
+	"Apex":                               {Type: "programming", Extensions: []string{".cls", ".apex", ".trigger"}},
+	"API Blueprint":                      {Type: "markup", Extensions: []string{".apib"}},
+	"APL":                                {Type: "programming", Extensions: []string{".apl", ".dyalog"}},
+	"Apollo Guidance Computer":           {Type: "programming", Extensions: []string{".agc"}},
+	"AppleScript":                        {Type: "programming", Extensions: []string{".applescript", ".scpt"}, Aliases: []string{"apples", "osascript"}},
+	"Arc":                                {Type: "programming", Extensions: []string{".arc"}},
+	"AsciiDoc":                           {Type: "prose", Extensions: []string{".asciidoc", ".adoc", ".asc"}},
+	"ASL":                                {Type: "programming", Extensions: []string{".asl", ".dsl"}},
+	"ASN.1":                              {Type: "data", Extensions: []string{".asn", ".asn1"}},
+	"ASP.NET":                            {Type: "programming", Extensions: []string{".asax", ".ascx", ".ashx", ".asmx", ".aspx", ".axd"}, Aliases: []string{"aspx", "aspx-vb"}},
+	"AspectJ":                            {Type: "programming", Extensions: []string{".aj"}},
+	"Assembly":                           {Type: "programming", Extensions: []string{".asm", ".a51", ".i", ".inc", ".nas", ".nasm", ".s"}, Aliases: []string{"asm", "nasm"}},
+	"Astro":                              {Type: "markup", Extensions: []string{".astro"}},
+	"Asymptote":                          {Type: "programming", Extensions: []string{".asy"}},
+	"ATS":                                {Type: "programming", Extensions: []string{".dats", ".hats", ".sats"}, Aliases: []string{"ats2"}},
+	"Augeas":                             {Type: "programming", Extensions: []string{".aug"}},
+	"AutoHotkey":                         {Type: "programming", Extensions: []string{".ahk", ".ahkl"}, Aliases: []string{"ahk"}},
+	"AutoIt":                             {Type: "programming", Extensions: []string{".au3"}, Aliases: []string{"au3", "AutoIt3", "AutoItScript"}},
+	"Avro IDL":                           {Type: "data", Extensions: []string{".avdl"}},
+	"Awk":                                {Type: "programming", Extensions: []string{".awk", ".auk", ".gawk", ".mawk", ".nawk"}},
+	"B (Formal Method)":                  {Type: "programming", Extensions: []string{".mch"}},
+	"B4X":                                {Type: "programming", Extensions: []string{".bas"}, Aliases: []string{"basic for android"}},
+	"Ballerina":                          {Type: "programming", Extensions: []string{".bal"}},
+	"BASIC":                              {Type: "programming", Extensions: []string{".bas"}},
+	"Batchfile":                          {Type: "programming", Extensions: []string{".bat", ".cmd"}, Aliases: []string{"bat", "batch", "dosbatch", "winbatch"}},
+	"Beef":                               {Type: "programming", Extensions: []string{".bf"}},
+	"Befunge":                            {Type: "programming", Extensions: []string{".befunge", ".bf"}},
+	"Berry":                              {Type: "programming", Extensions: []string{".be"}, Aliases: []string{"be"}},
+	"BibTeX":                             {Type: "markup", Extensions: []string{".bib", ".bibtex"}},
+	"BibTeX Style":                       {Type: "programming", Extensions: []string{".bst"}},
+	"Bicep":                              {Type: "programming", Extensions: []string{".bicep", ".bicepparam"}},
+	"Bikeshed":                           {Type: "markup", Extensions: []string{".bs"}},
+	"Bison":                              {Type: "programming", Extensions: []string{".bison"}},
+	"BitBake":                            {Type: "programming", Extensions: []string{".bb", ".bbappend", ".bbclass", ".inc"}},
+	"Blade":                              {Type: "markup", Extensions: []string{".blade", ".blade.php"}},
+	"BlitzBasic":                         {Type: "programming", Extensions: []string{".bb", ".decls"}, Aliases: []string{"b3d", "blitz3d", "blitzplus", "bplus"}},
+	"BlitzMax":                           {Type: "programming", Extensions: []string{".bmx"}, Aliases: []string{"bmax"}},
+	"Bluespec":                           {Type: "programming", Extensions: []string{".bsv"}, Aliases: []string{"bluespec bsv", "bsv"}},
+	"Bluespec BH":                        {Type: "programming", Extensions: []string{".bs"}, Aliases: []string{"bh", "bluespec classic"}},
+	"Boo":                                {Type: "programming", Extensions: []string{".boo"}},
+	"Boogie":                             {Type: "programming", Extensions: []string{".bpl"}},
+	"BQN":                                {Type: "programming", Extensions: []string{".bqn"}},
+	"Brainfuck":                          {Type: "programming", Extensions: []string{".b", ".bf"}},
+	"BrighterScript":                     {Type: "programming", Extensions: []string{".bs"}},
+	"Brightscript":                       {Type: "programming", Extensions: []string{".brs"}},
+	"Browserslist":                       {Type: "data"},
+	"Bru":                                {Type: "markup", Extensions: []string{".bru"}},
+	"BuildStream":                        {Type: "data", Extensions: []string{".bst"}},
+	"C":                                  {Type: "programming", Extensions: []string{".c", ".cats", ".h", ".h.in", ".idc"}},
+	"C#":                                 {Type: "programming", Extensions: []string{".cs", ".cake", ".cs.pp", ".csx", ".linq"}, Aliases: []string{"csharp", "cake", "cakescript"}},
+	"C++":                                {Type: "programming", Extensions: []string{".cpp", ".c++", ".cc", ".cp", ".cppm", ".cxx", ".h", ".h++", ".hh", ".hpp", ".hxx", ".inc", ".inl", ".ino", ".ipp", ".ixx", ".re", ".tcc", ".tpp", ".txx"}, Aliases: []string{"cpp"}},
+	"C-ObjDump":                          {Type: "data", Extensions: []string{".c-objdump"}},
+	"C2hs Haskell":                       {Type: "programming", Extensions: []string{".chs"}, Aliases: []string{"c2hs"}},
+	"C3":                                 {Type: "programming", Extensions: []string{".c3"}},
+	"Cabal Config":                       {Type: "data", Extensions: []string{".cabal"}, Aliases: []string{"Cabal"}},
+	"Caddyfile":                          {Type: "data", Extensions: []string{".caddyfile"}, Aliases: []string{"Caddy"}},
+	"Cadence":                            {Type: "programming", Extensions: []string{".cdc"}},
+	"Cairo":                              {Type: "programming", Extensions: []string{".cairo"}},
+	"Cairo Zero":                         {Type: "programming", Extensions: []string{".cairo"}},
+	"CameLIGO":                           {Type: "programming", Extensions: []string{".mligo"}},
+	"Cangjie":                            {Type: "programming", Extensions: []string{".cj"}},
+	"CAP CDS":                            {Type: "programming", Extensions: []string{".cds"}, Aliases: []string{"cds"}},
+	"Cap'n Proto":                        {Type: "programming", Extensions: []string{".capnp"}},
+	"Carbon":                             {Type: "programming", Extensions: []string{".carbon"}},
+	"CartoCSS":                           {Type: "programming", Extensions: []string{".mss"}, Aliases: []string{"Carto"}},
+	"Ceylon":                             {Type: "programming", Extensions: []string{".ceylon"}},
+	"Chapel":                             {Type: "programming", Extensions: []string{".chpl"}, Aliases: []string{"chpl"}},
+	"Charity":                            {Type: "programming", Extensions: []string{".ch"}},
+	"Checksums":                          {Type: "data", Extensions: []string{".crc32", ".md2", ".md4", ".md5", ".sha1", ".sha2", ".sha224", ".sha256", ".sha256sum", ".sha3", ".sha384", ".sha512"}, Aliases: []string{"checksum", "hash", "hashes", "sum", "sums"}},
+	"ChucK":                              {Type: "programming", Extensions: []string{".ck"}},
+	"CIL":                                {Type: "data", Extensions: []string{".cil"}},
+	"Circom":                             {Type: "programming", Extensions: []string{".circom"}},
+	"Cirru":                              {Type: "programming", Extensions: []string{".cirru"}},
+	"Clarion":                            {Type: "programming", Extensions: []string{".clw"}},
+	"Clarity":                            {Type: "programming", Extensions: []string{".clar"}},
+	"Classic ASP":                        {Type: "programming", Extensions: []string{".asp"}, Aliases: []string{"asp"}},
+	"Clean":                              {Type: "programming", Extensions: []string{".icl", ".dcl"}},
+	"Click":                              {Type: "programming", Extensions: []string{".click"}},
+	"CLIPS":                              {Type: "programming", Extensions: []string{".clp"}},
+	"Clojure":                            {Type: "programming", Extensions: []string{".clj", ".bb", ".boot", ".cl2", ".cljc", ".cljs", ".cljs.hl", ".cljscm", ".cljx", ".hic"}},
+	"Closure Templates":                  {Type: "markup", Extensions: []string{".soy"}, Aliases: []string{"soy"}},
+	"Cloud Firestore Security Rules":     {Type: "data"},
+	"Clue":                               {Type: "programming", Extensions: []string{".clue"}},
+	"CMake":                              {Type: "programming", Extensions: []string{".cmake", ".cmake.in"}},
+	"COBOL":                              {Type: "programming", Extensions: []string{".cob", ".cbl", ".ccp", ".cobol", ".cpy"}},
+	"CODEOWNERS":                         {Type: "data"},
+	"CodeQL":                             {Type: "programming", Extensions: []string{".ql", ".qll"}, Aliases: []string{"ql"}},
+	"CoffeeScript":                       {Type: "programming", Extensions: []string{".coffee", "._coffee", ".cake", ".cjsx", ".iced"}, Aliases: []string{"coffee", "coffee-script"}},
+	"ColdFusion":                         {Type: "programming", Extensions: []string{".cfm", ".cfml"}, Aliases: []string{"cfm", "cfml", "coldfusion html"}},
+	"ColdFusion CFC":                     {Type: "programming", Extensions: []string{".cfc"}, Aliases: []string{"cfc"}},
+	"COLLADA":                            {Type: "data", Extensions: []string{".dae"}},
+	"Common Lisp":                        {Type: "programming", Extensions: []string{".lisp", ".asd", ".cl", ".l", ".lsp", ".ny", ".podsl", ".sexp"}, Aliases: []string{"lisp"}},
+	"Common Workflow Language":           {Type: "programming", Extensions: []string{".cwl"}, Aliases: []string{"cwl"}},
+	"Component Pascal":                   {Type: "programming", Extensions: []string{".cp", ".cps"}},
+	"CoNLL-U":                            {Type: "data", Extensions: []string{".conllu", ".conll"}, Aliases: []string{"CoNLL", "CoNLL-X"}},
+	"Cooklang":                           {Type: "markup", Extensions: []string{".cook"}},
+	"Cool":                               {Type: "programming", Extensions: []string{".cl"}},
+	"Cpp-ObjDump":                        {Type: "data", Extensions: []string{".cppobjdump", ".c++-objdump", ".c++objdump", ".cpp-objdump", ".cxx-objdump"}, Aliases: []string{"c++-objdump"}},
+	"CQL":                                {Type: "programming", Extensions: []string{".cql"}},
+	"Creole":                             {Type: "prose", Extensions: []string{".creole"}},
+	"crontab":                            {Type: "data", Aliases: []string{"cron", "cron table"}},
+	"Crystal":                            {Type: "programming", Extensions: []string{".cr"}},
+	"CSON":                               {Type: "data", Extensions: []string{".cson"}},
+	"Csound":                             {Type: "programming", Extensions: []string{".orc", ".udo"}, Aliases: []string{"csound-orc"}},
+	"Csound Document":                    {Type: "programming", Extensions: []string{".csd"}, Aliases: []string{"csound-csd"}},
+	"Csound Score":                       {Type: "programming", Extensions: []string{".sco"}, Aliases: []string{"csound-sco"}},
+	"CSS":                                {Type: "markup", Extensions: []string{".css"}},
+	"CSV":                                {Type: "data", Extensions: []string{".csv"}},
+	"Cuda":                               {Type: "programming", Extensions: []string{".cu", ".cuh"}},
+	"CUE":                                {Type: "programming", Extensions: []string{".cue"}},
+	"Cue Sheet":                          {Type: "data", Extensions: []string{".cue"}},
+	"cURL Config":                        {Type: "data", Aliases: []string{"curlrc"}},
+	"Curry":                              {Type: "programming", Extensions: []string{".curry"}},
+	"CWeb":                               {Type: "programming", Extensions: []string{".w"}},
+	"Cycript":                            {Type: "programming", Extensions: []string{".cy"}},
+	"Cylc":                               {Type: "data", Extensions: []string{".cylc"}},
+	"Cypher":                             {Type: "programming", Extensions: []string{".cyp", ".cypher"}},
+	"Cython":                             {Type: "programming", Extensions: []string{".pyx", ".pxd", ".pxi"}, Aliases: []string{"pyrex"}},
+	"D":                                  {Type: "programming", Extensions: []string{".d", ".di"}, Aliases: []string{"Dlang"}},
+	"D-ObjDump":                          {Type: "data", Extensions: []string{".d-objdump"}},
+	"D2":                                 {Type: "markup", Extensions: []string{".d2"}, Aliases: []string{"d2lang"}},
+	"Dafny":                              {Type: "programming", Extensions: []string{".dfy"}},
+	"Darcs Patch":                        {Type: "data", Extensions: []string{".darcspatch", ".dpatch"}, Aliases: []string{"dpatch"}},
+	"Dart":                               {Type: "programming", Extensions: []string{".dart"}},
+	"Daslang":                            {Type: "programming", Extensions: []string{".das"}},
+	"DataWeave":                          {Type: "programming", Extensions: []string{".dwl"}},
+	"Debian Package Control File":        {Type: "data", Extensions: []string{".dsc"}},
+	"DenizenScript":                      {Type: "programming", Extensions: []string{".dsc"}},
+	"desktop":                            {Type: "data", Extensions: []string{".desktop", ".desktop.in", ".service"}},
+	"Dhall":                              {Type: "programming", Extensions: []string{".dhall"}},
+	"Diff":                               {Type: "data", Extensions: []string{".diff", ".patch"}, Aliases: []string{"udiff"}},
+	"DIGITAL Command Language":           {Type: "programming", Extensions: []string{".com"}, Aliases: []string{"dcl"}},
+	"dircolors":                          {Type: "data", Extensions: []string{".dircolors"}},
+	"DirectX 3D File":                    {Type: "data", Extensions: []string{".x"}},
+	"DM":                                 {Type: "programming", Extensions: []string{".dm"}, Aliases: []string{"byond"}},
+	"DNS Zone":                           {Type: "data", Extensions: []string{".zone", ".arpa"}},
+	"Dockerfile":                         {Type: "programming", Extensions: []string{".dockerfile", ".containerfile"}, Aliases: []string{"Containerfile"}},
+	"Dogescript":                         {Type: "programming", Extensions: []string{".djs"}},
+	"Dotenv":                             {Type: "data", Extensions: []string{".env"}},
+	"DTrace":                             {Type: "programming", Extensions: []string{".d"}, Aliases: []string{"dtrace-script"}},
+	"Dune":                               {Type: "programming"},
+	"Dylan":                              {Type: "programming", Extensions: []string{".dylan", ".dyl", ".intr", ".lid"}},
+	"E":                                  {Type: "programming", Extensions: []string{".e"}},
+	"E-mail":                             {Type: "data", Extensions: []string{".eml", ".mbox"}, Aliases: []string{"email", "eml", "mail", "mbox"}},
+	"Eagle":                              {Type: "data", Extensions: []string{".sch", ".brd"}},
+	"Earthly":                            {Type: "programming", Aliases: []string{"Earthfile"}},
+	"Easybuild":                          {Type: "data", Extensions: []string{".eb"}},
+	"EBNF":                               {Type: "data", Extensions: []string{".ebnf"}},
+	"eC":                                 {Type: "programming", Extensions: []string{".ec", ".eh"}},
+	"Ecere Projects":                     {Type: "data", Extensions: []string{".epj"}},
+	"ECL":                                {Type: "programming", Extensions: []string{".ecl", ".eclxml"}},
+	"ECLiPSe":                            {Type: "programming", Extensions: []string{".ecl"}},
+	"Ecmarkup":                           {Type: "markup", Extensions: []string{".html"}, Aliases: []string{"ecmarkdown"}},
+	"Edge":                               {Type: "markup", Extensions: []string{".edge"}},
+	"EdgeQL":                             {Type: "programming", Extensions: []string{".edgeql", ".esdl"}, Aliases: []string{"esdl"}},
+	"EditorConfig":                       {Type: "data", Extensions: []string{".editorconfig"}, Aliases: []string{"editor-config"}},
+	"Edje Data Collection":               {Type: "data", Extensions: []string{".edc"}},
+	"edn":                                {Type: "data", Extensions: []string{".edn"}},
+	"Eiffel":                             {Type: "programming", Extensions: []string{".e"}},
+	"EJS":                                {Type: "markup", Extensions: []string{".ejs", ".ect", ".ejs.t", ".jst"}},
+	"Elixir":                             {Type: "programming", Extensions: []string{".ex", ".exs"}},
+	"Elm":                                {Type: "programming", Extensions: []string{".elm"}},
+	"Elvish":                             {Type: "programming", Extensions: []string{".elv"}},
+	"Elvish Transcript":                  {Type: "programming"},
+	"Emacs Lisp":                         {Type: "programming", Extensions: []string{".el", ".emacs", ".emacs.desktop"}, Aliases: []string{"cask", "eask", "elisp", "emacs"}},
+	"EmberScript":                        {Type: "programming", Extensions: []string{".em", ".emberscript"}},
+	"EQ":                                 {Type: "programming", Extensions: []string{".eq"}},
+	"Erlang":                             {Type: "programming", Extensions: []string{".erl", ".app", ".app.src", ".es", ".escript", ".hrl", ".xrl", ".yrl"}},
+	"Euphoria":                           {Type: "programming", Extensions: []string{".e", ".ex"}},
+	"F#":                                 {Type: "programming", Extensions: []string{".fs", ".fsi", ".fsx"}, Aliases: []string{"fsharp"}},
+	"F*":                                 {Type: "programming", Extensions: []string{".fst", ".fsti"}, Aliases: []string{"fstar"}},
+	"Factor":                             {Type: "programming", Extensions: []string{".factor"}},
+	"Fancy":                              {Type: "programming", Extensions: []string{".fy", ".fancypack"}},
+	"Fantom":                             {Type: "programming", Extensions: []string{".fan"}},
+	"Faust":                              {Type: "programming", Extensions: []string{".dsp"}},
+	"Fennel":                             {Type: "programming", Extensions: []string{".fnl"}},
+	"FIGlet Font":                        {Type: "data", Extensions: []string{".flf"}, Aliases: []string{"FIGfont"}},
+	"Filebench WML":                      {Type: "programming", Extensions: []string{".f"}},
+	"Filterscript":                       {Type: "programming", Extensions: []string{".fs"}},
+	"FIRRTL":                             {Type: "programming", Extensions: []string{".fir"}},
+	"fish":                               {Type: "programming", Extensions: []string{".fish"}},
+	"FlatBuffers":                        {Type: "data", Extensions: []string{".fbs"}},
+	"Flix":                               {Type: "programming", Extensions: []string{".flix"}},
+	"Fluent":                             {Type: "programming", Extensions: []string{".ftl"}},
+	"FLUX":                               {Type: "programming", Extensions: []string{".fx", ".flux"}},
+	"Formatted":                          {Type: "data", Extensions: []string{".for", ".eam.fs"}},
+	"Forth":                              {Type: "programming", Extensions: []string{".fth", ".4th", ".f", ".for", ".forth", ".fr", ".frt", ".fs"}},
+	"Fortran":                            {Type: "programming", Extensions: []string{".f", ".f77", ".for", ".fpp"}},
+	"Fortran Free Form":                  {Type: "programming", Extensions: []string{".f90", ".f03", ".f08", ".f95"}},
+	"FreeBASIC":                          {Type: "programming", Extensions: []string{".bi", ".bas"}, Aliases: []string{"fb"}},
+	"FreeMarker":                         {Type: "programming", Extensions: []string{".ftl", ".ftlh"}, Aliases: []string{"ftl"}},
+	"Frege":                              {Type: "programming", Extensions: []string{".fr"}},
+	"Futhark":                            {Type: "programming", Extensions: []string{".fut"}},
+	"G-code":                             {Type: "programming", Extensions: []string{".g", ".cnc", ".gco", ".gcode"}},
+	"Game Maker Language":                {Type: "programming", Extensions: []string{".gml"}},
+	"GAML":                               {Type: "programming", Extensions: []string{".gaml"}},
+	"GAMS":                               {Type: "programming", Extensions: []string{".gms"}},
+	"GAP":                                {Type: "programming", Extensions: []string{".g", ".gap", ".gd", ".gi", ".tst"}},
+	"GCC Machine Description":            {Type: "programming", Extensions: []string{".md"}},
+	"GDB":                                {Type: "programming", Extensions: []string{".gdb", ".gdbinit"}},
+	"GDScript":                           {Type: "programming", Extensions: []string{".gd"}},
+	"GDShader":                           {Type: "programming", Extensions: []string{".gdshader", ".gdshaderinc"}},
+	"GEDCOM":                             {Type: "data", Extensions: []string{".ged"}},
+	"Gemfile.lock":                       {Type: "data"},
+	"Gemini":                             {Type: "prose", Extensions: []string{".gmi"}, Aliases: []string{"gemtext"}},
+	"Genero 4gl":                         {Type: "programming", Extensions: []string{".4gl"}},
+	"Genero per":                         {Type: "markup", Extensions: []string{".per"}},
+	"Genie":                              {Type: "programming", Extensions: []string{".gs"}},
+	"Genshi":                             {Type: "programming", Extensions: []string{".kid"}, Aliases: []string{"xml+genshi", "xml+kid"}},
+	"Gentoo Ebuild":                      {Type: "programming", Extensions: []string{".ebuild"}},
+	"Gentoo Eclass":                      {Type: "programming", Extensions: []string{".eclass"}},
+	"Gerber Image":                       {Type: "data", Extensions: []string{".gbr", ".cmp", ".gbl", ".gbo", ".gbp", ".gbs", ".gko", ".gml", ".gpb", ".gpt", ".gtl", ".gto", ".gtp", ".gts", ".ncl", ".sol"}, Aliases: []string{"rs-274x"}},
+	"Gettext Catalog":                    {Type: "prose", Extensions: []string{".po", ".pot"}, Aliases: []string{"pot"}},
+	"Gherkin":                            {Type: "programming", Extensions: []string{".feature", ".story"}, Aliases: []string{"cucumber"}},
+	"Git Attributes":                     {Type: "data", Aliases: []string{"gitattributes"}},
+	"Git Commit":                         {Type: "data", Aliases: []string{"commit"}},
+	"Git Config":                         {Type: "data", Extensions: []string{".gitconfig"}, Aliases: []string{"gitconfig", "gitmodules"}},
+	"Git Revision List":                  {Type: "data", Aliases: []string{"Git Blame Ignore Revs"}},
+	"Gleam":                              {Type: "programming", Extensions: []string{".gleam"}},
+	"Glimmer JS":                         {Type: "programming", Extensions: []string{".gjs"}, Aliases: []string{"gjs"}},
+	"Glimmer TS":                         {Type: "programming", Extensions: []string{".gts"}, Aliases: []string{"gts"}},
+	"GLSL":                               {Type: "programming", Extensions: []string{".glsl", ".fp", ".frag", ".frg", ".fs", ".fsh", ".fshader", ".geo", ".geom", ".glslf", ".glslv", ".gs", ".gshader", ".rchit", ".rmiss", ".shader", ".tesc", ".tese", ".vert", ".vrx", ".vs", ".vsh", ".vshader"}},
+	"Glyph":                              {Type: "programming", Extensions: []string{".glf"}},
+	"Glyph Bitmap Distribution Format":   {Type: "data", Extensions: []string{".bdf"}},
+	"GN":                                 {Type: "data", Extensions: []string{".gn", ".gni"}},
+	"Gnuplot":                            {Type: "programming", Extensions: []string{".gp", ".gnu", ".gnuplot", ".p", ".plot", ".plt"}},
+	"Go":                                 {Type: "programming", Extensions: []string{".go"}, Aliases: []string{"golang"}},
+	"Go Checksums":                       {Type: "data", Aliases: []string{"go.sum", "go sum", "go.work.sum", "go work sum"}},
+	"Go Module":                          {Type: "data", Aliases: []string{"go.mod", "go mod"}},
+	"Go Template":                        {Type: "markup", Extensions: []string{".gohtml", ".gotmpl", ".html.tmpl", ".tmpl", ".tpl"}, Aliases: []string{"gotmpl"}},
+	"Go Workspace":                       {Type: "data", Aliases: []string{"go.work", "go work"}},
+	"Godot Resource":                     {Type: "data", Extensions: []string{".gdnlib", ".gdns", ".tres", ".tscn"}},
+	"Golo":                               {Type: "programming", Extensions: []string{".golo"}},
+	"Gosu":                               {Type: "programming", Extensions: []string{".gs", ".gst", ".gsx", ".vark"}},
+	"Grace":                              {Type: "programming", Extensions: []string{".grace"}},
+	"Gradle":                             {Type: "data", Extensions: []string{".gradle"}},
+	"Gradle Kotlin DSL":                  {Type: "data", Extensions: []string{".gradle.kts"}},
+	"Grammatical Framework":              {Type: "programming", Extensions: []string{".gf"}, Aliases: []string{"gf"}},
+	"Graph Modeling Language":            {Type: "data", Extensions: []string{".gml"}},
+	"GraphQL":                            {Type: "data", Extensions: []string{".graphql", ".gql", ".graphqls"}},
+	"Graphviz (DOT)":                     {Type: "data", Extensions: []string{".dot", ".gv"}},
+	"Groovy":                             {Type: "programming", Extensions: []string{".groovy", ".grt", ".gtpl", ".gvy"}},
+	"Groovy Server Pages":                {Type: "programming", Extensions: []string{".gsp"}, Aliases: []string{"gsp", "java server page"}},
+	"GSC":                                {Type: "programming", Extensions: []string{".gsc", ".csc", ".gsh"}},
+	"Hack":                               {Type: "programming", Extensions: []string{".hack", ".hh", ".hhi", ".php"}},
+	"Haml":                               {Type: "markup", Extensions: []string{".haml", ".haml.deface"}},
+	"Handlebars":                         {Type: "markup", Extensions: []string{".handlebars", ".hbs"}, Aliases: []string{"hbs", "htmlbars"}},
+	"HAProxy":                            {Type: "data", Extensions: []string{".cfg"}},
+	"Harbour":                            {Type: "programming", Extensions: []string{".hb"}},
+	"Hare":                               {Type: "programming", Extensions: []string{".ha"}},
+	"Haskell":                            {Type: "programming", Extensions: []string{".hs", ".hs-boot", ".hsc"}},
+	"Haxe":                               {Type: "programming", Extensions: []string{".hx", ".hxsl"}},
+	"HCL":                                {Type: "programming", Extensions: []string{".hcl", ".nomad", ".tf", ".tfvars", ".tofu", ".workflow"}, Aliases: []string{"HashiCorp Configuration Language", "opentofu", "terraform"}},
+	"HIP":                                {Type: "programming", Extensions: []string{".hip"}},
+	"HiveQL":                             {Type: "programming", Extensions: []string{".q", ".hql"}},
+	"HLSL":                               {Type: "programming", Extensions: []string{".hlsl", ".cginc", ".fx", ".fxh", ".hlsli"}},
+	"HOCON":                              {Type: "data", Extensions: []string{".hocon"}},
+	"HolyC":                              {Type: "programming", Extensions: []string{".hc"}},
+	"hoon":                               {Type: "programming", Extensions: []string{".hoon"}},
+	"Hosts File":                         {Type: "data", Aliases: []string{"hosts"}},
+	"HTML":                               {Type: "markup", Extensions: []string{".html", ".hta", ".htm", ".html.hl", ".inc", ".xht", ".xhtml"}, Aliases: []string{"xhtml"}},
+	"HTML+ECR":                           {Type: "markup", Extensions: []string{".ecr"}, Aliases: []string{"ecr"}},
+	"HTML+EEX":                           {Type: "markup", Extensions: []string{".html.eex", ".heex", ".leex"}, Aliases: []string{"eex", "heex", "leex"}},
+	"HTML+ERB":                           {Type: "markup", Extensions: []string{".erb", ".erb.deface", ".rhtml"}, Aliases: []string{"erb", "rhtml", "html+ruby"}},
+	"HTML+PHP":                           {Type: "markup", Extensions: []string{".phtml"}},
+	"HTML+Razor":                         {Type: "markup", Extensions: []string{".cshtml", ".razor"}, Aliases: []string{"razor"}},
+	"HTTP":                               {Type: "data", Extensions: []string{".http"}},
+	"Hurl":                               {Type: "programming", Extensions: []string{".hurl"}},
+	"HXML":                               {Type: "data", Extensions: []string{".hxml"}},
+	"Hy":                                 {Type: "programming", Extensions: []string{".hy"}, Aliases: []string{"hylang"}},
+	"HyPhy":                              {Type: "programming", Extensions: []string{".bf"}},
+	"iCalendar":                          {Type: "data", Extensions: []string{".ics", ".ical"}, Aliases: []string{"iCal"}},
+	"IDL":                                {Type: "programming", Extensions: []string{".pro", ".dlm"}},
+	"Idris":                              {Type: "programming", Extensions: []string{".idr", ".lidr"}},
+	"Ignore List":                        {Type: "data", Extensions: []string{".gitignore"}, Aliases: []string{"ignore", "gitignore", "git-ignore"}},
+	"IGOR Pro":                           {Type: "programming", Extensions: []string{".ipf"}, Aliases: []string{"igor", "igorpro"}},
+	"ImageJ Macro":                       {Type: "programming", Extensions: []string{".ijm"}, Aliases: []string{"ijm"}},
+	"Imba":                               {Type: "programming", Extensions: []string{".imba"}},
+	"Inform 7":                           {Type: "programming", Extensions: []string{".ni", ".i7x"}, Aliases: []string{"i7", "inform7"}},
+	"INI":                                {Type: "data", Extensions: []string{".ini", ".cfg", ".cnf", ".dof", ".frm", ".lektorproject", ".prefs", ".pro", ".properties", ".url"}, Aliases: []string{"dosini"}},
+	"Ink":                                {Type: "programming", Extensions: []string{".ink"}},
+	"Inno Setup":                         {Type: "programming", Extensions: []string{".iss", ".isl"}},
+	"Io":                                 {Type: "programming", Extensions: []string{".io"}},
+	"Ioke":                               {Type: "programming", Extensions: []string{".ik"}},
+	"IRC log":                            {Type: "data", Extensions: []string{".irclog", ".weechatlog"}, Aliases: []string{"irc", "irc logs"}},
+	"Isabelle":                           {Type: "programming", Extensions: []string{".thy"}},
+	"Isabelle ROOT":                      {Type: "programming"},
+	"ISPC":                               {Type: "programming", Extensions: []string{".ispc"}},
+	"J":                                  {Type: "programming", Extensions: []string{".ijs"}},
+	"Jac":                                {Type: "programming", Extensions: []string{".jac"}},
+	"Jai":                                {Type: "programming", Extensions: []string{".jai"}},
+	"Janet":                              {Type: "programming", Extensions: []string{".janet"}},
+	"JAR Manifest":                       {Type: "data"},
+	"Jasmin":                             {Type: "programming", Extensions: []string{".j"}},
+	"Java":                               {Type: "programming", Extensions: []string{".java", ".jav", ".jsh"}},
+	"Java Properties":                    {Type: "data", Extensions: []string{".properties"}},
+	"Java Server Pages":                  {Type: "programming", Extensions: []string{".jsp", ".tag"}, Aliases: []string{"jsp"}},
+	"Java Template Engine":               {Type: "programming", Extensions: []string{".jte"}, Aliases: []string{"jte"}},
+	"JavaScript":                         {Type: "programming", Extensions: []string{".js", "._js", ".bones", ".cjs", ".es", ".es6", ".frag", ".gs", ".jake", ".javascript", ".jsb", ".jscad", ".jsfl", ".jslib", ".jsm", ".jspre", ".jss", ".jsx", ".mjs", ".njs", ".pac", ".sjs", ".ssjs", ".xsjs", ".xsjslib"}, Aliases: []string{"js", "node"}},
+	"JavaScript+ERB":                     {Type: "programming", Extensions: []string{".js.erb"}},
+	"JCL":                                {Type: "programming", Extensions: []string{".jcl"}},
+	"Jest Snapshot":                      {Type: "data", Extensions: []string{".snap"}},
+	"JetBrains MPS":                      {Type: "programming", Extensions: []string{".mps", ".mpl", ".msd"}, Aliases: []string{"mps"}},
+	"JFlex":                              {Type: "programming", Extensions: []string{".flex", ".jflex"}},
+	"Jinja":                              {Type: "markup", Extensions: []string{".jinja", ".j2", ".jinja2"}, Aliases: []string{"django", "html+django", "html+jinja", "htmldjango"}},
+	"Jison":                              {Type: "programming", Extensions: []string{".jison"}},
+	"Jison Lex":                          {Type: "programming", Extensions: []string{".jisonlex"}},
+	"Jolie":                              {Type: "programming", Extensions: []string{".ol", ".iol"}},
+	"jq":                                 {Type: "programming", Extensions: []string{".jq"}},
+	"JSON":                               {Type: "data", Extensions: []string{".json", ".4DForm", ".4DProject", ".avsc", ".geojson", ".gltf", ".har", ".ice", ".JSON-tmLanguage", ".json.example", ".jsonl", ".mcmeta", ".sarif", ".tact", ".tfstate", ".tfstate.backup", ".topojson", ".webapp", ".webmanifest", ".yy", ".yyp"}, Aliases: []string{"geojson", "jsonl", "sarif", "topojson"}},
+	"JSON with Comments":                 {Type: "data", Extensions: []string{".jsonc", ".code-snippets", ".code-workspace", ".sublime-build", ".sublime-color-scheme", ".sublime-commands", ".sublime-completions", ".sublime-keymap", ".sublime-macro", ".sublime-menu", ".sublime-mousemap", ".sublime-project", ".sublime-settings", ".sublime-theme", ".sublime-workspace", ".sublime_metrics", ".sublime_session", ".tsconfig.json"}, Aliases: []string{"jsonc"}},
+	"JSON5":                              {Type: "data", Extensions: []string{".json5"}},
+	"JSONiq":                             {Type: "programming", Extensions: []string{".jq"}},
+	"JSONLD":                             {Type: "data", Extensions: []string{".jsonld"}},
+	"Jsonnet":                            {Type: "programming", Extensions: []string{".jsonnet", ".libsonnet"}},
+	"Julia":                              {Type: "programming", Extensions: []string{".jl"}},
+	"Julia REPL":                         {Type: "programming"},
+	"Jupyter Notebook":                   {Type: "markup", Extensions: []string{".ipynb"}, Aliases: []string{"IPython Notebook"}},
+	"Just":                               {Type: "programming", Extensions: []string{".just"}, Aliases: []string{"Justfile"}},
+	"Kaitai Struct":                      {Type: "programming", Extensions: []string{".ksy"}, Aliases: []string{"ksy"}},
+	"KakouneScript":                      {Type: "programming", Extensions: []string{".kak"}, Aliases: []string{"kak", "kakscript"}},
+	"KCL":                                {Type: "programming", Extensions: []string{".k"}},
+	"KDL":                                {Type: "data", Extensions: []string{".kdl"}},
+	"KerboScript":                        {Type: "programming", Extensions: []string{".ks"}},
+	"KFramework":                         {Type: "programming", Extensions: []string{".k"}},
+	"KiCad Layout":                       {Type: "data", Extensions: []string{".kicad_pcb", ".kicad_mod", ".kicad_wks"}, Aliases: []string{"pcbnew"}},
+	"KiCad Legacy Layout":                {Type: "data", Extensions: []string{".brd"}},
+	"KiCad Schematic":                    {Type: "data", Extensions: []string{".kicad_sch", ".kicad_sym", ".sch"}, Aliases: []string{"eeschema schematic"}},
+	"Kickstart":                          {Type: "data", Extensions: []string{".ks"}},
+	"Kit":                                {Type: "markup", Extensions: []string{".kit"}},
+	"Koka":                               {Type: "programming", Extensions: []string{".kk"}},
+	"KoLmafia ASH":                       {Type: "programming", Extensions: []string{".ash"}},
+	"Kotlin":                             {Type: "programming", Extensions: []string{".kt", ".ktm", ".kts"}},
+	"KRL":                                {Type: "programming", Extensions: []string{".krl"}},
+	"Kusto":                              {Type: "data", Extensions: []string{".csl", ".kql"}},
+	"kvlang":                             {Type: "markup", Extensions: []string{".kv"}},
+	"LabVIEW":                            {Type: "programming", Extensions: []string{".lvproj", ".lvclass", ".lvlib"}},
+	"Lambdapi":                           {Type: "programming", Extensions: []string{".lp"}},
+	"Langium":                            {Type: "programming", Extensions: []string{".langium"}},
+	"Lark":                               {Type: "data", Extensions: []string{".lark"}},
+	"Lasso":                              {Type: "programming", Extensions: []string{".lasso", ".las", ".lasso8", ".lasso9"}, Aliases: []string{"lassoscript"}},
+	"Latte":                              {Type: "markup", Extensions: []string{".latte"}},
+	"Lean":                               {Type: "programming", Extensions: []string{".lean", ".hlean"}},
+	"Lean 4":                             {Type: "programming", Extensions: []string{".lean"}, Aliases: []string{"lean4"}},
+	"Leo":                                {Type: "programming", Extensions: []string{".leo"}},
+	"Less":                               {Type: "markup", Extensions: []string{".less"}, Aliases: []string{"less-css"}},
+	"Lex":                                {Type: "programming", Extensions: []string{".l", ".lex"}, Aliases: []string{"flex"}},
+	"LFE":                                {Type: "programming", Extensions: []string{".lfe"}},
+	"LigoLANG":                           {Type: "programming", Extensions: []string{".ligo"}},
+	"LilyPond":                           {Type: "programming", Extensions: []string{".ly", ".ily"}},
+	"Limbo":                              {Type: "programming", Extensions: []string{".b", ".m"}},
+	"Linear Programming":                 {Type: "programming", Extensions: []string{".lp"}},
+	"Linker Script":                      {Type: "programming", Extensions: []string{".ld", ".lds", ".x"}},
+	"Linux Kernel Module":                {Type: "data", Extensions: []string{".mod"}},
+	"Liquid":                             {Type: "markup", Extensions: []string{".liquid"}},
+	"Liquidsoap":                         {Type: "programming", Extensions: []string{".liq"}},
+	"Literate Agda":                      {Type: "programming", Extensions: []string{".lagda"}},
+	"Literate CoffeeScript":              {Type: "programming", Extensions: []string{".litcoffee", ".coffee.md"}, Aliases: []string{"litcoffee"}},
+	"Literate Haskell":                   {Type: "programming", Extensions: []string{".lhs"}, Aliases: []string{"lhaskell", "lhs"}},
+	"LiveCode Script":                    {Type: "programming", Extensions: []string{".livecodescript"}},
+	"LiveScript":                         {Type: "programming", Extensions: []string{".ls", "._ls"}, Aliases: []string{"live-script", "ls"}},
+	"LLVM":                               {Type: "programming", Extensions: []string{".ll"}},
+	"Logos":                              {Type: "programming", Extensions: []string{".xm", ".x", ".xi"}},
+	"Logtalk":                            {Type: "programming", Extensions: []string{".lgt", ".logtalk"}},
+	"LOLCODE":                            {Type: "programming", Extensions: []string{".lol"}},
+	"LookML":                             {Type: "programming", Extensions: []string{".lkml", ".lookml"}},
+	"LoomScript":                         {Type: "programming", Extensions: []string{".ls"}},
+	"LSL":                                {Type: "programming", Extensions: []string{".lsl", ".lslp"}},
+	"LTspice Symbol":                     {Type: "data", Extensions: []string{".asy"}},
+	"Lua":                                {Type: "programming", Extensions: []string{".lua", ".fcgi", ".nse", ".p8", ".pd_lua", ".rbxs", ".rockspec", ".wlua"}},
+	"Luau":                               {Type: "programming", Extensions: []string{".luau"}},
+	"M":                                  {Type: "programming", Extensions: []string{".mumps", ".m"}, Aliases: []string{"mumps"}},
+	"M3U":                                {Type: "data", Extensions: []string{".m3u", ".m3u8"}, Aliases: []string{"hls playlist", "m3u playlist"}},
+	"M4":                                 {Type: "programming", Extensions: []string{".m4", ".mc"}},
+	"M4Sugar":                            {Type: "programming", Extensions: []string{".m4"}, Aliases: []string{"autoconf"}},
+	"Macaulay2":                          {Type: "programming", Extensions: []string{".m2"}, Aliases: []string{"m2"}},
+	"Makefile":                           {Type: "programming", Extensions: []string{".mak", ".d", ".make", ".makefile", ".mk", ".mkfile"}, Aliases: []string{"bsdmake", "make", "mf"}},
+	"Mako":                               {Type: "programming", Extensions: []string{".mako", ".mao"}},
+	"Markdown":                           {Type: "prose", Extensions: []string{".md", ".livemd", ".markdown", ".mdown", ".mdwn", ".mkd", ".mkdn", ".mkdown", ".ronn", ".scd", ".workbook"}, Aliases: []string{"md", "pandoc"}},
+	"Marko":                              {Type: "markup", Extensions: []string{".marko"}, Aliases: []string{"markojs"}},
+	"Mask":                               {Type: "markup", Extensions: []string{".mask"}},
+	"Mathematical Programming System":    {Type: "programming", Extensions: []string{".mps"}},
+	"MATLAB":                             {Type: "programming", Extensions: []string{".matlab", ".m"}, Aliases: []string{"octave"}},
+	"Maven POM":                          {Type: "data"},
+	"Max":                                {Type: "programming", Extensions: []string{".maxpat", ".maxhelp", ".maxproj", ".mxt", ".pat"}, Aliases: []string{"max/msp", "maxmsp"}},
+	"MAXScript":                          {Type: "programming", Extensions: []string{".ms", ".mcr"}},
+	"mcfunction":                         {Type: "programming", Extensions: []string{".mcfunction"}},
+	"mdsvex":                             {Type: "markup", Extensions: []string{".svx"}},
+	"MDX":                                {Type: "markup", Extensions: []string{".mdx"}},
+	"Mercury":                            {Type: "programming", Extensions: []string{".m", ".moo"}},
+	"Mermaid":                            {Type: "markup", Extensions: []string{".mmd", ".mermaid"}, Aliases: []string{"mermaid example"}},
+	"Meson":                              {Type: "programming"},
+	"Metal":                              {Type: "programming", Extensions: []string{".metal"}},
+	"MeTTa":                              {Type: "programming", Extensions: []string{".metta"}},
+	"Microsoft Developer Studio Project": {Type: "data", Extensions: []string{".dsp"}},
+	"Microsoft Visual Studio Solution":   {Type: "data", Extensions: []string{".sln"}},
+	"MiniD":                              {Type: "programming", Extensions: []string{".minid"}},
+	"MiniYAML":                           {Type: "data", Extensions: []string{".yaml", ".yml"}},
+	"MiniZinc":                           {Type: "programming", Extensions: []string{".mzn"}},
+	"MiniZinc Data":                      {Type: "data", Extensions: []string{".dzn"}},
+	"Mint":                               {Type: "programming", Extensions: []string{".mint"}},
+	"Mirah":                              {Type: "programming", Extensions: []string{".druby", ".duby", ".mirah"}},
+	"mIRC Script":                        {Type: "programming", Extensions: []string{".mrc"}},
+	"MLIR":                               {Type: "programming", Extensions: []string{".mlir"}},
+	"Modelica":                           {Type: "programming", Extensions: []string{".mo"}},
+	"Modula-2":                           {Type: "programming", Extensions: []string{".mod"}},
+	"Modula-3":                           {Type: "programming", Extensions: []string{".i3", ".ig", ".m3", ".mg"}},
+	"Module Management System":           {Type: "programming", Extensions: []string{".mms", ".mmk"}},
+	"Mojo":                               {Type: "programming", Extensions: []string{".mojo"}},
+	"Monkey":                             {Type: "programming", Extensions: []string{".monkey", ".monkey2"}},
+	"Monkey C":                           {Type: "programming", Extensions: []string{".mc"}},
+	"Moocode":                            {Type: "programming", Extensions: []string{".moo"}},
+	"MoonBit":                            {Type: "programming", Extensions: []string{".mbt"}},
+	"MoonScript":                         {Type: "programming", Extensions: []string{".moon"}},
+	"Motoko":                             {Type: "programming", Extensions: []string{".mo"}},
+	"Motorola 68K Assembly":              {Type: "programming", Extensions: []string{".asm", ".i", ".inc", ".s", ".x68"}, Aliases: []string{"m68k"}},
+	"Move":                               {Type: "programming", Extensions: []string{".move"}},
+	"MQL4":                               {Type: "programming", Extensions: []string{".mq4", ".mqh"}},
+	"MQL5":                               {Type: "programming", Extensions: []string{".mq5", ".mqh"}},
+	"MTML":                               {Type: "markup", Extensions: []string{".mtml"}},
+	"MUF":                                {Type: "programming", Extensions: []string{".muf", ".m"}},
+	"mupad":                              {Type: "programming", Extensions: []string{".mu"}},
+	"Muse":                               {Type: "prose", Extensions: []string{".muse"}, Aliases: []string{"amusewiki", "emacs muse"}},
+	"Mustache":                           {Type: "markup", Extensions: []string{".mustache"}},
+	"Myghty":                             {Type: "programming", Extensions: []string{".myt"}},
+	"nanorc":                             {Type: "data", Extensions: []string{".nanorc"}},
+	"Nasal":                              {Type: "programming", Extensions: []string{".nas"}},
+	"NASL":                               {Type: "programming", Extensions: []string{".nasl", ".inc"}},
+	"NCL":                                {Type: "programming", Extensions: []string{".ncl"}},
+	"Nearley":                            {Type: "programming", Extensions: []string{".ne", ".nearley"}},
+	"Nemerle":                            {Type: "programming", Extensions: []string{".n"}},
+	"NEON":                               {Type: "data", Extensions: []string{".neon"}, Aliases: []string{"nette object notation", "ne-on"}},
+	"nesC":                               {Type: "programming", Extensions: []string{".nc"}},
+	"NetLinx":                            {Type: "programming", Extensions: []string{".axs", ".axi"}},
+	"NetLinx+ERB":                        {Type: "programming", Extensions: []string{".axs.erb", ".axi.erb"}},
+	"NetLogo":                            {Type: "programming", Extensions: []string{".nlogo"}},
+	"NewLisp":                            {Type: "programming", Extensions: []string{".nl", ".lisp", ".lsp"}},
+	"Nextflow":                           {Type: "programming", Extensions: []string{".nf"}},
+	"Nginx":                              {Type: "data", Extensions: []string{".nginx", ".nginxconf", ".vhost"}, Aliases: []string{"nginx configuration file"}},
+	"Nickel":                             {Type: "programming", Extensions: []string{".ncl"}},
+	"Nim":                                {Type: "programming", Extensions: []string{".nim", ".nim.cfg", ".nimble", ".nimrod", ".nims"}},
+	"Ninja":                              {Type: "data", Extensions: []string{".ninja"}},
+	"Nit":                                {Type: "programming", Extensions: []string{".nit"}},
+	"Nix":                                {Type: "programming", Extensions: []string{".nix"}, Aliases: []string{"nixos"}},
+	"NL":                                 {Type: "data", Extensions: []string{".nl"}},
+	"NMODL":                              {Type: "programming", Extensions: []string{".mod"}},
+	"Noir":                               {Type: "programming", Extensions: []string{".nr"}, Aliases: []string{"nargo"}},
+	"NPM Config":                         {Type: "data", Aliases: []string{"npmrc"}},
+	"NSIS":                               {Type: "programming", Extensions: []string{".nsi", ".nsh"}},
+	"Nu":                                 {Type: "programming", Extensions: []string{".nu"}, Aliases: []string{"nush"}},
+	"NumPy":                              {Type: "programming", Extensions: []string{".numpy", ".numpyw", ".numsc"}},
+	"Nunjucks":                           {Type: "markup", Extensions: []string{".njk"}, Aliases: []string{"njk"}},
+	"Nushell":                            {Type: "programming", Extensions: []string{".nu"}, Aliases: []string{"nu-script", "nushell-script"}},
+	"NWScript":                           {Type: "programming", Extensions: []string{".nss"}},
+	"OASv2-json":                         {Type: "data", Extensions: []string{".json"}},
+	"OASv2-yaml":                         {Type: "data", Extensions: []string{".yaml", ".yml"}},
+	"OASv3-json":                         {Type: "data", Extensions: []string{".json"}},
+	"OASv3-yaml":                         {Type: "data", Extensions: []string{".yaml", ".yml"}},
+	"Oberon":                             {Type: "programming", Extensions: []string{".ob2"}},
+	"ObjDump":                            {Type: "data", Extensions: []string{".objdump"}},
+	"Object Data Instance Notation":      {Type: "data", Extensions: []string{".odin"}},
+	"Objective-C":                        {Type: "programming", Extensions: []string{".m", ".h"}, Aliases: []string{"obj-c", "objc", "objectivec"}},
+	"Objective-C++":                      {Type: "programming", Extensions: []string{".mm"}, Aliases: []string{"obj-c++", "objc++", "objectivec++"}},
+	"Objective-J":                        {Type: "programming", Extensions: []string{".j", ".sj"}, Aliases: []string{"obj-j", "objectivej", "objj"}},
+	"ObjectScript":                       {Type: "programming", Extensions: []string{".cls"}},
+	"OCaml":                              {Type: "programming", Extensions: []string{".ml", ".eliom", ".eliomi", ".ml4", ".mli", ".mll", ".mly"}},
+	"Odin":                               {Type: "programming", Extensions: []string{".odin"}, Aliases: []string{"odinlang", "odin-lang"}},
+	"Omgrofl":                            {Type: "programming", Extensions: []string{".omgrofl"}},
+	"OMNeT++ MSG":                        {Type: "programming", Extensions: []string{".msg"}, Aliases: []string{"omnetpp-msg"}},
+	"OMNeT++ NED":                        {Type: "programming", Extensions: []string{".ned"}, Aliases: []string{"omnetpp-ned"}},
+	"ooc":                                {Type: "programming", Extensions: []string{".ooc"}},
+	"Opa":                                {Type: "programming", Extensions: []string{".opa"}},
+	"Opal":                               {Type: "programming", Extensions: []string{".opal"}},
+	"Open Policy Agent":                  {Type: "programming", Extensions: []string{".rego"}},
+	"OpenAPI Specification v2":           {Type: "data", Aliases: []string{"oasv2"}},
+	"OpenAPI Specification v3":           {Type: "data", Aliases: []string{"oasv3"}},
+	"OpenCL":                             {Type: "programming", Extensions: []string{".cl", ".opencl"}},
+	"OpenEdge ABL":                       {Type: "programming", Extensions: []string{".p", ".cls", ".w"}, Aliases: []string{"progress", "openedge", "abl"}},
+	"OpenQASM":                           {Type: "programming", Extensions: []string{".qasm"}},
+	"OpenRC runscript":                   {Type: "programming", Aliases: []string{"openrc"}},
+	"OpenSCAD":                           {Type: "programming", Extensions: []string{".scad"}},
+	"OpenStep Property List":             {Type: "data", Extensions: []string{".plist", ".glyphs"}},
+	"OpenType Feature File":              {Type: "data", Extensions: []string{".fea"}, Aliases: []string{"AFDKO"}},
+	"Option List":                        {Type: "data", Aliases: []string{"opts", "ackrc"}},
+	"Org":                                {Type: "prose", Extensions: []string{".org"}},
+	"OverpassQL":                         {Type: "programming", Extensions: []string{".overpassql"}},
+	"Ox":                                 {Type: "programming", Extensions: []string{".ox", ".oxh", ".oxo"}},
+	"Oxygene":                            {Type: "programming", Extensions: []string{".oxygene"}},
+	"Oz":                                 {Type: "programming", Extensions: []string{".oz"}},
+	"P4":                                 {Type: "programming", Extensions: []string{".p4"}},
+	"Pact":                               {Type: "programming", Extensions: []string{".pact"}},
+	"Pan":                                {Type: "programming", Extensions: []string{".pan"}},
+	"Papyrus":                            {Type: "programming", Extensions: []string{".psc"}},
+	"Parrot":                             {Type: "programming", Extensions: []string{".parrot"}},
+	"Parrot Assembly":                    {Type: "programming", Extensions: []string{".pasm"}, Aliases: []string{"pasm"}},
+	"Parrot Internal Representation":     {Type: "programming", Extensions: []string{".pir"}, Aliases: []string{"pir"}},
+	"Pascal":                             {Type: "programming", Extensions: []string{".pas", ".dfm", ".dpr", ".inc", ".lpr", ".pascal", ".pp"}, Aliases: []string{"delphi", "objectpascal"}},
+	"Pawn":                               {Type: "programming", Extensions: []string{".pwn", ".inc", ".sma"}},
+	"PDDL":                               {Type: "programming", Extensions: []string{".pddl"}},
+	"PEG.js":                             {Type: "programming", Extensions: []string{".pegjs", ".peggy"}},
+	"Pep8":                               {Type: "programming", Extensions: []string{".pep"}},
+	"Perl":                               {Type: "programming", Extensions: []string{".pl", ".al", ".cgi", ".fcgi", ".perl", ".ph", ".plx", ".pm", ".psgi", ".t"}, Aliases: []string{"cperl"}},
+	"PHP":                                {Type: "programming", Extensions: []string{".php", ".aw", ".ctp", ".fcgi", ".inc", ".php3", ".php4", ".php5", ".phps", ".phpt"}, Aliases: []string{"inc"}},
+	"Pic":                                {Type: "markup", Extensions: []string{".pic", ".chem"}, Aliases: []string{"pikchr"}},
+	"Pickle":                             {Type: "data", Extensions: []string{".pkl"}},
+	"PicoLisp":                           {Type: "programming", Extensions: []string{".l"}},
+	"PigLatin":                           {Type: "programming", Extensions: []string{".pig"}},
+	"Pike":                               {Type: "programming", Extensions: []string{".pike", ".pmod"}},
+	"Pip Requirements":                   {Type: "data"},
+	"Pkl":                                {Type: "programming", Extensions: []string{".pkl"}},
+	"PlantUML":                           {Type: "data", Extensions: []string{".puml", ".iuml", ".plantuml"}},
+	"PLpgSQL":                            {Type: "programming", Extensions: []string{".pgsql", ".sql"}},
+	"PLSQL":                              {Type: "programming", Extensions: []string{".pls", ".bdy", ".ddl", ".fnc", ".pck", ".pkb", ".pks", ".plb", ".plsql", ".prc", ".spc", ".sql", ".tpb", ".tps", ".trg", ".vw"}},
+	"Pod":                                {Type: "prose", Extensions: []string{".pod"}},
+	"Pod 6":                              {Type: "prose", Extensions: []string{".pod", ".pod6"}},
+	"PogoScript":                         {Type: "programming", Extensions: []string{".pogo"}},
+	"Polar":                              {Type: "programming", Extensions: []string{".polar"}},
+	"Pony":                               {Type: "programming", Extensions: []string{".pony"}},
+	"Portugol":                           {Type: "programming", Extensions: []string{".por"}},
+	"PostCSS":                            {Type: "markup", Extensions: []string{".pcss", ".postcss"}},
+	"PostScript":                         {Type: "markup", Extensions: []string{".ps", ".eps", ".epsi", ".pfa"}, Aliases: []string{"postscr"}},
+	"POV-Ray SDL":                        {Type: "programming", Extensions: []string{".pov", ".inc"}, Aliases: []string{"pov-ray", "povray"}},
+	"PowerBuilder":                       {Type: "programming", Extensions: []string{".pbt", ".sra", ".sru", ".srw"}},
+	"PowerShell":                         {Type: "programming", Extensions: []string{".ps1", ".psd1", ".psm1"}, Aliases: []string{"posh", "pwsh"}},
+	"Praat":                              {Type: "programming", Extensions: []string{".praat"}},
+	"Prisma":                             {Type: "data", Extensions: []string{".prisma"}},
+	"Processing":                         {Type: "programming", Extensions: []string{".pde"}},
+	"Procfile":                           {Type: "programming"},
+	"Proguard":                           {Type: "data", Extensions: []string{".pro"}},
+	"Prolog":                             {Type: "programming", Extensions: []string{".pl", ".plt", ".pro", ".prolog", ".yap"}},
+	"Promela":                            {Type: "programming", Extensions: []string{".pml"}},
+	"Propeller Spin":                     {Type: "programming", Extensions: []string{".spin"}},
+	"Protocol Buffer":                    {Type: "data", Extensions: []string{".proto"}, Aliases: []string{"proto", "protobuf", "Protocol Buffers"}},
+	"Protocol Buffer Text Format":        {Type: "data", Extensions: []string{".textproto", ".pbt", ".pbtxt", ".txtpb"}, Aliases: []string{"text proto", "protobuf text format"}},
+	"Public Key":                         {Type: "data", Extensions: []string{".asc", ".pub"}},
+	"Pug":                                {Type: "markup", Extensions: []string{".jade", ".pug"}},
+	"Puppet":                             {Type: "programming", Extensions: []string{".pp"}},
+	"Pure Data":                          {Type: "data", Extensions: []string{".pd"}},
+	"PureBasic":                          {Type: "programming", Extensions: []string{".pb", ".pbi"}},
+	"PureScript":                         {Type: "programming", Extensions: []string{".purs"}},
+	"Pyret":                              {Type: "programming", Extensions: []string{".arr"}},
+	"Python":                             {Type: "programming", Extensions: []string{".py", ".cgi", ".fcgi", ".gyp", ".gypi", ".lmi", ".py3", ".pyde", ".pyi", ".pyp", ".pyt", ".pyw", ".rpy", ".spec", ".tac", ".wsgi", ".xpy"}, Aliases: []string{"py", "py3", "python3", "rusthon"}},
+	"Python console":                     {Type: "programming", Aliases: []string{"pycon"}},
+	"Python traceback":                   {Type: "data", Extensions: []string{".pytb"}},
+	"q":                                  {Type: "programming", Extensions: []string{".q"}},
+	"Q#":                                 {Type: "programming", Extensions: []string{".qs"}, Aliases: []string{"qsharp"}},
+	"QMake":                              {Type: "programming", Extensions: []string{".pro", ".pri"}},
+	"QML":                                {Type: "programming", Extensions: []string{".qml", ".qbs"}},
+	"Qt Script":                          {Type: "programming", Extensions: []string{".qs"}},
+	"Quake":                              {Type: "programming"},
+	"QuakeC":                             {Type: "programming", Extensions: []string{".qc"}},
+	"QuickBASIC":                         {Type: "programming", Extensions: []string{".bas", ".bi"}, Aliases: []string{"qb", "qbasic", "qb64", "classic qbasic", "classic quickbasic"}},
+	"R":                                  {Type: "programming", Extensions: []string{".r", ".rd", ".rsx"}, Aliases: []string{"Rscript", "splus"}},
+	"Racket":                             {Type: "programming", Extensions: []string{".rkt", ".rktd", ".rktl", ".scrbl"}},
+	"Ragel":                              {Type: "programming", Extensions: []string{".rl"}, Aliases: []string{"ragel-rb", "ragel-ruby"}},
+	"Raku":                               {Type: "programming", Extensions: []string{".6pl", ".6pm", ".nqp", ".p6", ".p6l", ".p6m", ".pl", ".pl6", ".pm", ".pm6", ".raku", ".rakumod", ".t"}, Aliases: []string{"perl6", "perl-6"}},
+	"RAML":                               {Type: "markup", Extensions: []string{".raml"}},
+	"Rascal":                             {Type: "programming", Extensions: []string{".rsc"}},
+	"RAScript":                           {Type: "programming", Extensions: []string{".rascript"}},
+	"Raw token data":                     {Type: "data", Extensions: []string{".raw"}, Aliases: []string{"raw"}},
+	"RBS":                                {Type: "data", Extensions: []string{".rbs"}},
+	"RDoc":                               {Type: "prose", Extensions: []string{".rdoc"}},
+	"Readline Config":                    {Type: "data", Aliases: []string{"inputrc", "readline"}},
+	"REALbasic":                          {Type: "programming", Extensions: []string{".rbbas", ".rbfrm", ".rbmnu", ".rbres", ".rbtbar", ".rbuistate"}},
+	"Reason":                             {Type: "programming", Extensions: []string{".re", ".rei"}},
+	"ReasonLIGO":                         {Type: "programming", Extensions: []string{".religo"}},
+	"Rebol":                              {Type: "programming", Extensions: []string{".reb", ".r", ".r2", ".r3", ".rebol"}},
+	"Record Jar":                         {Type: "data"},
+	"Red":                                {Type: "programming", Extensions: []string{".red", ".reds"}, Aliases: []string{"red/system"}},
+	"Redcode":                            {Type: "programming", Extensions: []string{".cw"}},
+	"Redirect Rules":                     {Type: "data", Aliases: []string{"redirects"}},
+	"Regular Expression":                 {Type: "data", Extensions: []string{".regexp", ".regex"}, Aliases: []string{"regexp", "regex"}},
+	"Ren'Py":                             {Type: "programming", Extensions: []string{".rpy"}, Aliases: []string{"renpy"}},
+	"RenderScript":                       {Type: "programming", Extensions: []string{".rs", ".rsh"}},
+	"ReScript":                           {Type: "programming", Extensions: []string{".res", ".resi"}},
+	"reStructuredText":                   {Type: "prose", Extensions: []string{".rst", ".rest", ".rest.txt", ".rst.txt"}, Aliases: []string{"rst"}},
+	"REXX":                               {Type: "programming", Extensions: []string{".rexx", ".pprx", ".rex"}, Aliases: []string{"arexx"}},
+	"Rez":                                {Type: "programming", Extensions: []string{".r"}},
+	"Rich Text Format":                   {Type: "markup", Extensions: []string{".rtf"}},
+	"Ring":                               {Type: "programming", Extensions: []string{".ring"}},
+	"Riot":                               {Type: "markup", Extensions: []string{".riot"}},
+	"RMarkdown":                          {Type: "prose", Extensions: []string{".qmd", ".rmd"}},
+	"RobotFramework":                     {Type: "programming", Extensions: []string{".robot", ".resource"}},
+	"robots.txt":                         {Type: "data", Aliases: []string{"robots", "robots txt"}},
+	"Roc":                                {Type: "programming", Extensions: []string{".roc"}},
+	"Rocq Prover":                        {Type: "programming", Extensions: []string{".v", ".coq"}, Aliases: []string{"coq", "rocq"}},
+	"Roff":                               {Type: "markup", Extensions: []string{".roff", ".1", ".1in", ".1m", ".1x", ".2", ".3", ".3in", ".3m", ".3p", ".3pm", ".3qt", ".3x", ".4", ".5", ".6", ".7", ".8", ".9", ".l", ".man", ".mdoc", ".me", ".ms", ".n", ".nr", ".rno", ".tmac"}, Aliases: []string{"groff", "man", "manpage", "man page", "man-page", "mdoc", "nroff", "troff"}},
+	"Roff Manpage":                       {Type: "markup", Extensions: []string{".1", ".1in", ".1m", ".1x", ".2", ".3", ".3in", ".3m", ".3p", ".3pm", ".3qt", ".3x", ".4", ".5", ".6", ".7", ".8", ".9", ".man", ".mdoc"}},
+	"RON":                                {Type: "data", Extensions: []string{".ron"}},
+	"ROS Interface":                      {Type: "data", Extensions: []string{".msg", ".action", ".srv"}, Aliases: []string{"rosmsg"}},
+	"Rouge":                              {Type: "programming", Extensions: []string{".rg"}},
+	"RouterOS Script":                    {Type: "programming", Extensions: []string{".rsc"}},
+	"RPC":                                {Type: "programming", Extensions: []string{".x"}, Aliases: []string{"rpcgen", "oncrpc", "xdr"}},
+	"RPGLE":                              {Type: "programming", Extensions: []string{".rpgle", ".sqlrpgle"}, Aliases: []string{"ile rpg", "sqlrpgle"}},
+	"RPM Spec":                           {Type: "data", Extensions: []string{".spec"}, Aliases: []string{"specfile"}},
+	"Ruby":                               {Type: "programming", Extensions: []string{".rb", ".builder", ".eye", ".fcgi", ".gemspec", ".god", ".jbuilder", ".mspec", ".pluginspec", ".podspec", ".prawn", ".rabl", ".rake", ".rbi", ".rbuild", ".rbw", ".rbx", ".ru", ".ruby", ".spec", ".thor", ".watchr"}, Aliases: []string{"jruby", "macruby", "rake", "rb", "rbx"}},
+	"RUNOFF":                             {Type: "markup", Extensions: []string{".rnh", ".rno"}},
+	"Rust":                               {Type: "programming", Extensions: []string{".rs", ".rs.in"}, Aliases: []string{"rs"}},
+	"Sage":                               {Type: "programming", Extensions: []string{".sage", ".sagews"}},
+	"Sail":                               {Type: "programming", Extensions: []string{".sail"}},
+	"SaltStack":                          {Type: "programming", Extensions: []string{".sls"}, Aliases: []string{"saltstate", "salt"}},
+	"SAS":                                {Type: "programming", Extensions: []string{".sas"}},
+	"Sass":                               {Type: "markup", Extensions: []string{".sass"}},
+	"Scala":                              {Type: "programming", Extensions: []string{".scala", ".kojo", ".sbt", ".sc"}},
+	"Scaml":                              {Type: "markup", Extensions: []string{".scaml"}},
+	"Scenic":                             {Type: "programming", Extensions: []string{".scenic"}},
+	"Scheme":                             {Type: "programming", Extensions: []string{".scm", ".sch", ".sld", ".sls", ".sps", ".ss"}},
+	"Scilab":                             {Type: "programming", Extensions: []string{".sci", ".sce", ".tst"}},
+	"SCSS":                               {Type: "markup", Extensions: []string{".scss"}},
+	"sed":                                {Type: "programming", Extensions: []string{".sed"}},
+	"Self":                               {Type: "programming", Extensions: []string{".self"}},
+	"SELinux Policy":                     {Type: "data", Extensions: []string{".te"}, Aliases: []string{"SELinux Kernel Policy Language", "sepolicy"}},
+	"ShaderLab":                          {Type: "programming", Extensions: []string{".shader"}},
+	"Shell":                              {Type: "programming", Extensions: []string{".sh", ".bash", ".bats", ".cgi", ".command", ".fcgi", ".ksh", ".sbatch", ".sh.in", ".slurm", ".tmux", ".tool", ".trigger", ".zsh", ".zsh-theme"}, Aliases: []string{"sh", "shell-script", "bash", "zsh", "envrc"}},
+	"ShellCheck Config":                  {Type: "data", Aliases: []string{"shellcheckrc"}},
+	"ShellSession":                       {Type: "programming", Extensions: []string{".sh-session"}, Aliases: []string{"bash session", "console"}},
+	"Shen":                               {Type: "programming", Extensions: []string{".shen"}},
+	"Sieve":                              {Type: "programming", Extensions: []string{".sieve"}},
+	"Simple File Verification":           {Type: "data", Extensions: []string{".sfv"}, Aliases: []string{"sfv"}},
+	"Singularity":                        {Type: "programming"},
+	"Slang":                              {Type: "programming", Extensions: []string{".slang"}},
+	"Slash":                              {Type: "programming", Extensions: []string{".sl"}},
+	"Slice":                              {Type: "programming", Extensions: []string{".ice"}},
+	"Slim":                               {Type: "markup", Extensions: []string{".slim"}},
+	"Slint":                              {Type: "markup", Extensions: []string{".slint"}},
+	"Smali":                              {Type: "programming", Extensions: []string{".smali"}},
+	"Smalltalk":                          {Type: "programming", Extensions: []string{".st", ".cs"}, Aliases: []string{"squeak"}},
+	"Smarty":                             {Type: "programming", Extensions: []string{".tpl"}},
+	"Smithy":                             {Type: "programming", Extensions: []string{".smithy"}},
+	"SmPL":                               {Type: "programming", Extensions: []string{".cocci"}, Aliases: []string{"coccinelle"}},
+	"SMT":                                {Type: "programming", Extensions: []string{".smt2", ".smt", ".z3"}},
+	"Snakemake":                          {Type: "programming", Extensions: []string{".smk", ".snakefile"}, Aliases: []string{"snakefile"}},
+	"Solidity":                           {Type: "programming", Extensions: []string{".sol"}},
+	"Soong":                              {Type: "data"},
+	"SourcePawn":                         {Type: "programming", Extensions: []string{".sp", ".inc"}, Aliases: []string{"sourcemod"}},
+	"SPARQL":                             {Type: "data", Extensions: []string{".sparql", ".rq"}},
+	"Spline Font Database":               {Type: "data", Extensions: []string{".sfd"}},
+	"SQF":                                {Type: "programming", Extensions: []string{".sqf", ".hqf"}},
+	"SQL":                                {Type: "data", Extensions: []string{".sql", ".ddl", ".inc", ".mysql", ".prc", ".tab", ".udf", ".viw"}},
+	"SQLPL":                              {Type: "programming", Extensions: []string{".sql", ".db2"}},
+	"Squirrel":                           {Type: "programming", Extensions: []string{".nut"}},
+	"SRecode Template":                   {Type: "markup", Extensions: []string{".srt"}},
+	"SSH Config":                         {Type: "data", Aliases: []string{"sshconfig", "sshdconfig", "ssh_config", "sshd_config"}},
+	"Stan":                               {Type: "programming", Extensions: []string{".stan"}},
+	"Standard ML":                        {Type: "programming", Extensions: []string{".ml", ".fun", ".sig", ".sml"}, Aliases: []string{"sml"}},
+	"STAR":                               {Type: "data", Extensions: []string{".star"}},
+	"Starlark":                           {Type: "programming", Extensions: []string{".bzl", ".star"}, Aliases: []string{"bazel", "bzl"}},
+	"Stata":                              {Type: "programming", Extensions: []string{".do", ".ado", ".doh", ".ihlp", ".mata", ".matah", ".sthlp"}},
+	"STL":                                {Type: "data", Extensions: []string{".stl"}, Aliases: []string{"ascii stl", "stla"}},
+	"STON":                               {Type: "data", Extensions: []string{".ston"}},
+	"StringTemplate":                     {Type: "markup", Extensions: []string{".st"}},
+	"Stylus":                             {Type: "markup", Extensions: []string{".styl"}},
+	"SubRip Text":                        {Type: "data", Extensions: []string{".srt"}},
+	"SugarSS":                            {Type: "markup", Extensions: []string{".sss"}},
+	"SuperCollider":                      {Type: "programming", Extensions: []string{".sc", ".scd"}},
+	"SurrealQL":                          {Type: "programming", Extensions: []string{".surql"}, Aliases: []string{"surql"}},
+	"Survex data":                        {Type: "data", Extensions: []string{".svx"}},
+	"Svelte":                             {Type: "markup", Extensions: []string{".svelte"}},
+	"SVG":                                {Type: "data", Extensions: []string{".svg"}},
+	"Sway":                               {Type: "programming", Extensions: []string{".sw"}},
+	"Sweave":                             {Type: "prose", Extensions: []string{".rnw"}},
+	"Swift":                              {Type: "programming", Extensions: []string{".swift"}},
+	"SWIG":                               {Type: "programming", Extensions: []string{".i", ".swg", ".swig"}},
+	"SystemVerilog":                      {Type: "programming", Extensions: []string{".sv", ".svh", ".vh"}},
+	"Tact":                               {Type: "programming", Extensions: []string{".tact"}},
+	"Talon":                              {Type: "programming", Extensions: []string{".talon"}},
+	"Tcl":                                {Type: "programming", Extensions: []string{".tcl", ".adp", ".sdc", ".tcl.in", ".tm", ".xdc"}, Aliases: []string{"sdc", "xdc"}},
+	"Tcsh":                               {Type: "programming", Extensions: []string{".tcsh", ".csh"}},
+	"Tea":                                {Type: "markup", Extensions: []string{".tea"}},
+	"Teal":                               {Type: "programming", Extensions: []string{".tl"}},
+	"templ":                              {Type: "markup", Extensions: []string{".templ"}},
+	"Terra":                              {Type: "programming", Extensions: []string{".t"}},
+	"Terraform Template":                 {Type: "markup", Extensions: []string{".tftpl"}},
+	"TeX":                                {Type: "markup", Extensions: []string{".tex", ".aux", ".bbx", ".cbx", ".cls", ".dtx", ".ins", ".lbx", ".ltx", ".mkii", ".mkiv", ".mkvi", ".sty", ".toc"}, Aliases: []string{"latex"}},
+	"Texinfo":                            {Type: "prose", Extensions: []string{".texinfo", ".texi", ".txi"}},
+	"Text":                               {Type: "prose", Extensions: []string{".txt", ".fr", ".nb", ".ncl", ".no"}, Aliases: []string{"fundamental", "plain text"}},
+	"TextGrid":                           {Type: "data", Extensions: []string{".TextGrid"}},
+	"Textile":                            {Type: "prose", Extensions: []string{".textile"}},
+	"TextMate Properties":                {Type: "data", Aliases: []string{"tm-properties"}},
+	"Thrift":                             {Type: "programming", Extensions: []string{".thrift"}},
+	"TI Program":                         {Type: "programming", Extensions: []string{".8xp", ".8xp.txt"}},
+	"TL-Verilog":                         {Type: "programming", Extensions: []string{".tlv"}},
+	"TLA":                                {Type: "programming", Extensions: []string{".tla"}},
+	"TMDL":                               {Type: "data", Extensions: []string{".tmdl"}, Aliases: []string{"Tabular Model Definition Language"}},
+	"Toit":                               {Type: "programming", Extensions: []string{".toit"}},
+	"TOML":                               {Type: "data", Extensions: []string{".toml", ".toml.example"}},
+	"Tor Config":                         {Type: "data", Aliases: []string{"torrc"}},
+	"Tree-sitter Query":                  {Type: "programming", Extensions: []string{".scm"}, Aliases: []string{"tsq"}},
+	"TSPLIB data":                        {Type: "data", Extensions: []string{".tsp"}, Aliases: []string{"travelling salesman problem", "traveling salesman problem"}},
+	"TSQL":                               {Type: "programming", Extensions: []string{".sql"}},
+	"TSV":                                {Type: "data", Extensions: []string{".tsv", ".vcf"}, Aliases: []string{"tab-seperated values"}},
+	"TSX":                                {Type: "programming", Extensions: []string{".tsx"}, Aliases: []string{"typescriptreact"}},
+	"Turing":                             {Type: "programming", Extensions: []string{".t", ".tu"}},
+	"Turtle":                             {Type: "data", Extensions: []string{".ttl"}},
+	"Twig":                               {Type: "markup", Extensions: []string{".twig"}},
+	"TXL":                                {Type: "programming", Extensions: []string{".txl"}},
+	"Type Language":                      {Type: "data", Extensions: []string{".tl"}, Aliases: []string{"tl"}},
+	"TypeScript":                         {Type: "programming", Extensions: []string{".ts", ".cts", ".mts"}, Aliases: []string{"ts"}},
+	"TypeSpec":                           {Type: "programming", Extensions: []string{".tsp"}, Aliases: []string{"tsp"}},
+	"Typst":                              {Type: "programming", Extensions: []string{".typ"}, Aliases: []string{"typ"}},
+	"Unified Parallel C":                 {Type: "programming", Extensions: []string{".upc"}},
+	"Unity3D Asset":                      {Type: "data", Extensions: []string{".anim", ".asset", ".mask", ".mat", ".meta", ".prefab", ".unity"}},
+	"Unix Assembly":                      {Type: "programming", Extensions: []string{".s", ".ms"}, Aliases: []string{"gas", "gnu asm", "unix asm"}},
+	"Uno":                                {Type: "programming", Extensions: []string{".uno"}},
+	"UnrealScript":                       {Type: "programming", Extensions: []string{".uc"}},
+	"Untyped Plutus Core":                {Type: "programming", Extensions: []string{".uplc"}},
+	"UrWeb":                              {Type: "programming", Extensions: []string{".ur", ".urs"}, Aliases: []string{"Ur/Web", "Ur"}},
+	"V":                                  {Type: "programming", Extensions: []string{".v"}, Aliases: []string{"vlang"}},
+	"Vala":                               {Type: "programming", Extensions: []string{".vala", ".vapi"}},
+	"Valve Data Format":                  {Type: "data", Extensions: []string{".vdf"}, Aliases: []string{"keyvalues", "vdf"}},
+	"VBA":                                {Type: "programming", Extensions: []string{".bas", ".cls", ".frm", ".vba"}, Aliases: []string{"visual basic for applications"}},
+	"VBScript":                           {Type: "programming", Extensions: []string{".vbs"}},
+	"vCard":                              {Type: "data", Extensions: []string{".vcf"}, Aliases: []string{"virtual contact file", "electronic business card"}},
+	"VCL":                                {Type: "programming", Extensions: []string{".vcl"}},
+	"Velocity Template Language":         {Type: "markup", Extensions: []string{".vtl"}, Aliases: []string{"vtl", "velocity"}},
+	"Vento":                              {Type: "markup", Extensions: []string{".vto"}},
+	"Verilog":                            {Type: "programming", Extensions: []string{".v", ".veo"}},
+	"VHDL":                               {Type: "programming", Extensions: []string{".vhdl", ".vhd", ".vhf", ".vhi", ".vho", ".vhs", ".vht", ".vhw"}},
+	"Vim Help File":                      {Type: "prose", Extensions: []string{".txt"}, Aliases: []string{"help", "vimhelp"}},
+	"Vim Script":                         {Type: "programming", Extensions: []string{".vim", ".vba", ".vimrc", ".vmb"}, Aliases: []string{"vim", "viml", "nvim", "vimscript"}},
+	"Vim Snippet":                        {Type: "markup", Extensions: []string{".snip", ".snippet", ".snippets"}, Aliases: []string{"SnipMate", "UltiSnip", "UltiSnips", "NeoSnippet"}},
+	"Visual Basic .NET":                  {Type: "programming", Extensions: []string{".vb", ".vbhtml"}, Aliases: []string{"visual basic", "vbnet", "vb .net", "vb.net"}},
+	"Visual Basic 6.0":                   {Type: "programming", Extensions: []string{".bas", ".cls", ".ctl", ".Dsr", ".frm"}, Aliases: []string{"vb6", "vb 6", "visual basic 6", "visual basic classic", "classic visual basic"}},
+	"Volt":                               {Type: "programming", Extensions: []string{".volt"}},
+	"Vue":                                {Type: "markup", Extensions: []string{".vue"}},
+	"Vyper":                              {Type: "programming", Extensions: []string{".vy"}},
+	"Wavefront Material":                 {Type: "data", Extensions: []string{".mtl"}},
+	"Wavefront Object":                   {Type: "data", Extensions: []string{".obj"}},
+	"WDL":                                {Type: "programming", Extensions: []string{".wdl"}, Aliases: []string{"Workflow Description Language"}},
+	"Web Ontology Language":              {Type: "data", Extensions: []string{".owl"}},
+	"WebAssembly":                        {Type: "programming", Extensions: []string{".wast", ".wat"}, Aliases: []string{"wast", "wasm"}},
+	"WebAssembly Interface Type":         {Type: "data", Extensions: []string{".wit"}, Aliases: []string{"wit"}},
+	"WebIDL":                             {Type: "programming", Extensions: []string{".webidl"}},
+	"WebVTT":                             {Type: "data", Extensions: []string{".vtt"}, Aliases: []string{"vtt"}},
+	"Wget Config":                        {Type: "data", Aliases: []string{"wgetrc"}},
+	"WGSL":                               {Type: "programming", Extensions: []string{".wgsl"}},
+	"Whiley":                             {Type: "programming", Extensions: []string{".whiley"}},
+	"Wikitext":                           {Type: "prose", Extensions: []string{".mediawiki", ".wiki", ".wikitext"}, Aliases: []string{"mediawiki", "wiki"}},
+	"Win32 Message File":                 {Type: "data", Extensions: []string{".mc"}},
+	"Windows Registry Entries":           {Type: "data", Extensions: []string{".reg"}},
+	"wisp":                               {Type: "programming", Extensions: []string{".wisp"}},
+	"Witcher Script":                     {Type: "programming", Extensions: []string{".ws"}},
+	"Wolfram Language":                   {Type: "programming", Extensions: []string{".mathematica", ".cdf", ".m", ".ma", ".mt", ".nb", ".nbp", ".wl", ".wls", ".wlt"}, Aliases: []string{"mathematica", "mma", "wolfram", "wolfram lang", "wl"}},
+	"Wollok":                             {Type: "programming", Extensions: []string{".wlk"}},
+	"World of Warcraft Addon Data":       {Type: "data", Extensions: []string{".toc"}},
+	"Wren":                               {Type: "programming", Extensions: []string{".wren"}, Aliases: []string{"wrenlang"}},
+	"X BitMap":                           {Type: "data", Extensions: []string{".xbm"}, Aliases: []string{"xbm"}},
+	"X Font Directory Index":             {Type: "data"},
+	"X PixMap":                           {Type: "data", Extensions: []string{".xpm", ".pm"}, Aliases: []string{"xpm"}},
+	"X10":                                {Type: "programming", Extensions: []string{".x10"}, Aliases: []string{"xten"}},
+	"xBase":                              {Type: "programming", Extensions: []string{".prg", ".ch", ".prw"}, Aliases: []string{"advpl", "clipper", "foxpro"}},
+	"XC":                                 {Type: "programming", Extensions: []string{".xc"}},
+	"XCompose":                           {Type: "data"},
+	"Xmake":                              {Type: "programming"},
+	"XML":                                {Type: "data", Extensions: []string{".xml", ".adml", ".admx", ".ant", ".axaml", ".axml", ".builds", ".ccproj", ".ccxml", ".clixml", ".cproject", ".cscfg", ".csdef", ".csl", ".csproj", ".ct", ".depproj", ".dita", ".ditamap", ".ditaval", ".dll.config", ".dotsettings", ".filters", ".fsproj", ".fxml", ".glade", ".gml", ".gmx", ".gpx", ".grxml", ".gst", ".hzp", ".icls", ".iml", ".ivy", ".jelly", ".jsproj", ".kml", ".launch", ".mdpolicy", ".mjml", ".mm", ".mod", ".mojo", ".mxml", ".natvis", ".ncl", ".ndproj", ".nproj", ".nuspec", ".odd", ".osm", ".pkgproj", ".pluginspec", ".proj", ".props", ".ps1xml", ".psc1", ".pt", ".pubxml", ".qhelp", ".rdf", ".res", ".resx", ".rs", ".rss", ".sch", ".scxml", ".sfproj", ".shproj", ".slnx", ".srdf", ".storyboard", ".sublime-snippet", ".sw", ".targets", ".tml", ".ts", ".tsx", ".typ", ".ui", ".urdf", ".ux", ".vbproj", ".vcxproj", ".vsixmanifest", ".vssettings", ".vstemplate", ".vxml", ".wixproj", ".workflow", ".wsdl", ".wsf", ".wxi", ".wxl", ".wxs", ".x3d", ".xacro", ".xaml", ".xib", ".xlf", ".xliff", ".xmi", ".xml.dist", ".xmp", ".xproj", ".xsd", ".xspec", ".xul", ".zcml"}, Aliases: []string{"rss", "xsd", "wsdl"}},
+	"XML Property List":                  {Type: "data", Extensions: []string{".plist", ".stTheme", ".tmCommand", ".tmLanguage", ".tmPreferences", ".tmSnippet", ".tmTheme"}},
+	"Xojo":                               {Type: "programming", Extensions: []string{".xojo_code", ".xojo_menu", ".xojo_report", ".xojo_script", ".xojo_toolbar", ".xojo_window"}},
+	"Xonsh":                              {Type: "programming", Extensions: []string{".xsh"}},
+	"XPages":                             {Type: "data", Extensions: []string{".xsp-config", ".xsp.metadata"}},
+	"XProc":                              {Type: "programming", Extensions: []string{".xpl", ".xproc"}},
+	"XQuery":                             {Type: "programming", Extensions: []string{".xquery", ".xq", ".xql", ".xqm", ".xqy"}},
+	"XS":                                 {Type: "programming", Extensions: []string{".xs"}},
+	"XSLT":                               {Type: "programming", Extensions: []string{".xslt", ".xsl"}, Aliases: []string{"xsl"}},
+	"Xtend":                              {Type: "programming", Extensions: []string{".xtend"}},
+	"Yacc":                               {Type: "programming", Extensions: []string{".y", ".yacc", ".yy"}},
+	"YAML":                               {Type: "data", Extensions: []string{".yml", ".mir", ".reek", ".rviz", ".sublime-syntax", ".syntax", ".yaml", ".yaml-tmlanguage", ".yaml.sed", ".yml.mysql"}, Aliases: []string{"yml"}},
+	"YANG":                               {Type: "data", Extensions: []string{".yang"}},
+	"YARA":                               {Type: "programming", Extensions: []string{".yar", ".yara"}},
+	"YASnippet":                          {Type: "markup", Extensions: []string{".yasnippet"}, Aliases: []string{"snippet", "yas"}},
+	"Yul":                                {Type: "programming", Extensions: []string{".yul"}},
+	"ZAP":                                {Type: "programming", Extensions: []string{".zap", ".xzap"}},
+	"Zeek":                               {Type: "programming", Extensions: []string{".zeek", ".bro"}, Aliases: []string{"bro"}},
+	"ZenScript":                          {Type: "programming", Extensions: []string{".zs"}},
+	"Zephir":                             {Type: "programming", Extensions: []string{".zep"}},
+	"Zig":                                {Type: "programming", Extensions: []string{".zig", ".zig.zon"}},
+	"ZIL":                                {Type: "programming", Extensions: []string{".zil", ".mud"}},
+	"Zimpl":                              {Type: "programming", Extensions: []string{".zimpl", ".zmpl", ".zpl"}},
+	"Zmodel":                             {Type: "data", Extensions: []string{".zmodel"}},
+}
@@ -0,0 +1,58 @@
+package colors
+
+import "strings"
+
+//go:generate go run ./internal/linguistgen
+
+// DefaultColor is used when there is no language name to derive a color from
+const DefaultColor = "#808080"
+
+// Language is linguist's metadata for a language
+type Language struct {
+	Type       string // programming, markup, data or prose
+	Extensions []string
+	Aliases    []string // Lowercase alternative names
+}
+
+// linguistAliases maps lowercase linguist aliases to language names
+var linguistAliases = func() map[string]string {
+	aliases := make(map[string]string)
+	for name, lang := range Languages {
+		for _, alias := range lang.Aliases {
+			aliases[strings.ToLower(alias)] = name
+		}
+	}
+	return aliases
+}()
+
+// Lookup returns the linguist metadata for a language name from linguist
+// or any supported counter
+func Lookup(language string) (Language, bool) {
+	lang, ok := Languages[LinguistName(language)]
+	return lang, ok
+}
+
+// GetColor returns the color for a language, looked up by its own name and
+// then by its linguist name. Languages without a color get one derived from
+// their name. The color is adjusted to contrast with the background set by
+// SetBackground.
+func GetColor(language string) string {
+	color, ok := linguistColor(language)
+	if !ok {
+		if language == "" {
+			return DefaultColor
+		}
+		color = HashColor(language)
+	}
+	return forBackground(color)
+}
+
+// linguistColor returns linguist's color for a language, looked up by its
+// own name and then by its linguist name
+func linguistColor(language string) (string, bool) {
+	if color, ok := LanguageColors[language]; ok {
+		return color, true
+	}
+	color, ok := LanguageColors[LinguistName(language)]
+	return color, ok
+}
@@ -0,0 +1,74 @@
+package colors
+
+// Linguist language types
+const (
+	TypeProgramming = "programming"
+	TypeMarkup      = "markup"
+	TypeData        = "data"
+	TypeProse       = "prose"
+)
+
+// Types lists the language types in display order
+var Types = []string{TypeProgramming, TypeMarkup, TypeData, TypeProse}
+
+// typeOverrides covers counter languages linguist has no entry for
+var typeOverrides = map[string]string{
+	"ADSO/IDSM":                 TypeProgramming,
+	"AMPLE":                     TypeProgramming,
+	"Arturo":                    TypeProgramming,
+	"CCS":                       TypeProgramming,
+	"CoCoA 5":                   TypeProgramming,
+	"DAL":                       TypeProgramming,
+	"Derw":                      TypeProgramming,
+	"DIET":                      TypeProgramming,
+	"DOORS Extension Language":  TypeProgramming,
+	"Drools":                    TypeProgramming,
+	"Finite State Language":     TypeProgramming,
+	"Focus":                     TypeProgramming,
+	"Gencat NLS":                TypeProse,
+	"InstallShield":             TypeProgramming,
+	"IPL":                       TypeProgramming,
+	"Jam":                       TypeProgramming,
+	"Juniper Junos":             TypeData,
+	"Kermit":                    TypeProgramming,
+	"LiveLink OScript":          TypeProgramming,
+	"Mojom":                     TypeData,
+	"NASTRAN DMAP":              TypeProgramming,
+	"Pest":                      TypeProgramming,
+	"PL/I":                      TypeProgramming,
+	"PL/M":                      TypeProgramming,
+	"PRQL":                      TypeProgramming,
+	"SKILL":                     TypeProgramming,
+	"SparForte":                 TypeProgramming,
+	"Specman e":                 TypeProgramming,
+	"TableGen":                  TypeProgramming,
+	"TNSDL":                     TypeProgramming,
+	"tspeg":                     TypeProgramming,
+	"TTCN":                      TypeProgramming,
+	"Umka":                      TypeProgramming,
+	"Windows Module Definition": TypeData,
+	"Windows Resource File":     TypeData,
+	"X++":                       TypeProgramming,
+	"Yarn":                      TypeProgramming,
+}
+
+// TypeOf returns the linguist type of a language, defaulting to programming
+// for languages without metadata
+func TypeOf(language string) string {
+	if t, ok := typeOf(language); ok {
+		return t
+	}
+	return TypeProgramming
+}
+
+// typeOf returns the type given for a language by an override or linguist,
+// and whether there is one
+func typeOf(language string) (string, bool) {
+	if t, ok := typeOverrides[language]; ok {
+		return t, true
+	}
+	if lang, ok := Lookup(language); ok && lang.Type != "" {
+		return lang.Type, true
+	}
+	return "", false
+}
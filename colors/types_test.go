@@ -0,0 +1,11 @@
+package colors
+
+import "testing"
+
+func TestClocLanguagesHaveTypes(t *testing.T) {
+	for _, name := range clocLanguages(t) {
+		if _, ok := typeOf(name); !ok {
+			t.Errorf("%q (linguist name %q) has no type", name, LinguistName(name))
+		}
+	}
+}
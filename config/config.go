@@ -0,0 +1,118 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config contains user settings loaded from the config file
+type Config struct {
+	Keys KeysConfig `yaml:"keys"`
+	// Theme is "auto" (match the terminal background) or a preset name
+	Theme string `yaml:"theme"`
+	// ThemeFile is a YAML file of colors overriding the theme
+	ThemeFile string `yaml:"theme_file"`
+	// Color is the color output mode: auto, always or never
+	Color string `yaml:"color"`
+	// Columns lists the language table columns after the name, in order
+	Columns []string `yaml:"columns"`
+	// Category is the language type shown at startup: all (the default),
+	// programming, markup, data or prose
+	Category string `yaml:"category"`
+	// Groups merges languages into one row named after the group
+	Groups map[string][]string `yaml:"groups"`
+	// Buckets sorts files by path for the bucket view; the first match wins
+	Buckets []Bucket `yaml:"buckets"`
+	// Tests configures how test files are recognised
+	Tests TestsConfig `yaml:"tests"`
+	// Hotspots configures the churn hotspots view
+	Hotspots HotspotsConfig `yaml:"hotspots"`
+	// Authors configures the authors view
+	Authors AuthorsConfig `yaml:"authors"`
+	// Submodules is how submodules and nested repositories are counted:
+	// "include" (the default) as part of the parent, "exclude" to leave them
+	// out, or "separate" to show each as a group of its own
+	Submodules string `yaml:"submodules"`
+}
+
+// AuthorsConfig sets how lines are attributed to authors
+type AuthorsConfig struct {
+	// Mode is "blame" (the default), attributing the current lines with git
+	// blame, or "numstat", approximating from the lines each author added,
+	// which is much faster on large repositories
+	Mode string `yaml:"mode"`
+	// Workers is how many files are blamed at once; the default is the
+	// number of CPUs
+	Workers int `yaml:"workers"`
+}
+
+// HotspotsConfig sets the history window churn is counted over
+type HotspotsConfig struct {
+	// Since is a git date such as "6 months ago" or "2024-01-01"; "all"
+	// counts the whole history. The default is "1 year ago".
+	Since string `yaml:"since"`
+}
+
+// TestsConfig sets the test file patterns and whether tests start hidden
+type TestsConfig struct {
+	// Hide leaves test files out of every view at startup
+	Hide bool `yaml:"hide"`
+	// Patterns maps language names to path globs replacing their default
+	// test patterns; "*" applies to every language
+	Patterns map[string][]string `yaml:"patterns"`
+}
+
+// Bucket names a set of files by path globs, relative to the scanned path
+type Bucket struct {
+	Name  string   `yaml:"name"`
+	Paths []string `yaml:"paths"`
+}
+
+// KeysConfig selects a keymap preset and overrides individual bindings
+type KeysConfig struct {
+	// Preset is one of "default", "vim" or "emacs"
+	Preset string `yaml:"preset"`
+	// Bindings maps binding names (e.g. "quit", "filter") to their keys
+	Bindings map[string][]string `yaml:"bindings"`
+}
+
+// Path returns the config file location: $GLOC_CONFIG if set, otherwise
+// gloc/config.yaml under the user config directory
+func Path() (string, error) {
+	if path := os.Getenv("GLOC_CONFIG"); path != "" {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gloc", "config.yaml"), nil
+}
+
+// Load reads the config file. A missing file yields the default config.
+func Load() (*Config, error) {
+	cfg := &Config{}
+
+	path, err := Path()
+	if err != nil {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return cfg, nil
+}
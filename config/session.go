@@ -0,0 +1,65 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Session contains UI state saved on exit and restored on the next run
+type Session struct {
+	// LanguageSort and FileSort are sort stacks, primary key first, as
+	// "column:asc" or "column:desc"
+	LanguageSort []string `yaml:"language_sort"`
+	FileSort     []string `yaml:"file_sort"`
+}
+
+// SessionPath returns the session file location under the user cache directory
+func SessionPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gloc", "session.yaml"), nil
+}
+
+// LoadSession reads the session file. A missing file yields an empty session.
+func LoadSession() (*Session, error) {
+	s := &Session{}
+
+	path, err := SessionPath()
+	if err != nil {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return &Session{}, err
+	}
+	return s, nil
+}
+
+// Save writes the session file, creating its directory if needed
+func (s *Session) Save() error {
+	path, err := SessionPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,13 +10,37 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/config"
 	"github.com/devin/gloc/ui"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		runReport(os.Args[2:])
+		return
+	}
+
+	colorMode := flag.String("color", "", "color output: auto, always or never")
+	themeName := flag.String("theme", "", "theme: auto, dark, light, high-contrast or colorblind")
+	submodules := flag.String("submodules", "", "submodules and nested repositories: include, exclude or separate")
+	worktree := flag.Bool("worktree", false, "start with the unstaged changes")
+	staged := flag.Bool("staged", false, "start with the staged changes")
+	mergeBase := flag.String("since-merge-base", "", "start with the changes on HEAD since it branched from `branch`")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gloc [flags] [path]")
+		fmt.Fprintln(os.Stderr, "       gloc report [flags]")
+		flag.PrintDefaults()
+	}
+	args := parseFlags(flag.CommandLine, os.Args[1:])
+	if len(args) > 1 {
+		fmt.Fprintf(os.Stderr, "Error: expected one path, got %d: %s\n", len(args), strings.Join(args, " "))
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	path := "."
-	if len(os.Args) > 1 {
-		path = os.Args[1]
+	if len(args) > 0 {
+		path = args[0]
 	}
 
 	// Expand ~ to home directory
@@ -29,13 +54,21 @@ func main() {
 	// Check if it's a git reference
 	isGit := cloc.IsGitRef(path)
 
+	diff, showDiff, err := diffFlags(*worktree, *staged, *mergeBase)
+	if err == nil && showDiff && isGit && diff.Kind != cloc.MergeBaseDiff {
+		err = fmt.Errorf("--worktree and --staged need a directory, not the git ref %s", path)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	var absPath string
 	if isGit {
 		// For git refs, use as-is
 		absPath = path
 	} else {
 		// Resolve to absolute path
-		var err error
 		absPath, err = filepath.Abs(path)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error resolving path: %v\n", err)
@@ -49,17 +82,99 @@ func main() {
 		}
 	}
 
-	// Check if cloc is installed
-	if _, err := exec.LookPath("cloc"); err != nil {
-		fmt.Fprintln(os.Stderr, "Error: 'cloc' is not installed. Please install it first.")
-		fmt.Fprintln(os.Stderr, "  macOS: brew install cloc")
-		fmt.Fprintln(os.Stderr, "  Ubuntu: apt install cloc")
+	checkCloc()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		os.Exit(1)
 	}
 
-	p := tea.NewProgram(ui.NewModel(absPath, isGit), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	// Flags take precedence over the config file
+	if *colorMode != "" {
+		cfg.Color = *colorMode
+	}
+	if *themeName != "" {
+		cfg.Theme = *themeName
+	}
+	if *submodules != "" {
+		cfg.Submodules = *submodules
+	}
+
+	model, err := ui.NewModel(absPath, isGit, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error in config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// A broken session file only loses the saved state
+	session, _ := config.LoadSession()
+	model.RestoreSession(session)
+	if showDiff {
+		model.ShowChanges(diff)
+	}
+
+	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion(), tea.WithOutput(ui.TerminalOutput))
+	final, err := p.Run()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
+
+	if m, ok := final.(ui.Model); ok {
+		if err := m.Session().Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving session: %v\n", err)
+		}
+	}
+}
+
+// parseFlags parses flags from anywhere among args, rather than stopping at
+// the first positional argument like flag.Parse, and returns the positional
+// arguments. Everything after "--" is positional.
+func parseFlags(flags *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		// The flag sets exit on errors
+		flags.Parse(args)
+		rest := flags.Args()
+		if len(rest) == 0 {
+			return positional
+		}
+		if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...)
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// diffFlags returns the diff chosen on the command line, if any
+func diffFlags(worktree, staged bool, mergeBase string) (cloc.Diff, bool, error) {
+	var diffs []cloc.Diff
+	if worktree {
+		diffs = append(diffs, cloc.Diff{Kind: cloc.WorktreeDiff})
+	}
+	if staged {
+		diffs = append(diffs, cloc.Diff{Kind: cloc.StagedDiff})
+	}
+	if mergeBase != "" {
+		diffs = append(diffs, cloc.Diff{Kind: cloc.MergeBaseDiff, Base: mergeBase})
+	}
+	switch len(diffs) {
+	case 0:
+		return cloc.Diff{}, false, nil
+	case 1:
+		return diffs[0], true, nil
+	}
+	return cloc.Diff{}, false, fmt.Errorf("choose one of --worktree, --staged and --since-merge-base")
+}
+
+// checkCloc exits with install instructions if cloc is missing
+func checkCloc() {
+	if _, err := exec.LookPath("cloc"); err != nil {
+		fmt.Fprintln(os.Stderr, "Error: 'cloc' is not installed. Please install it first.")
+		fmt.Fprintln(os.Stderr, "  macOS: brew install cloc")
+		fmt.Fprintln(os.Stderr, "  Ubuntu: apt install cloc")
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/report"
+)
+
+// runReport prints a Markdown report of the changes between two revisions
+// of the repository in the working directory, for a pull request comment
+func runReport(args []string) {
+	flags := flag.NewFlagSet("report", flag.ExitOnError)
+	base := flags.String("base", "main", "branch the changes are counted from, at its merge base with head")
+	head := flags.String("head", "HEAD", "revision with the changes")
+	format := flags.String("format", "md", "output format: md")
+	top := flags.Int("top", 10, "changed files listed, or 0 for all")
+	flags.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: gloc report [--base REV] [--head REV] [--format md] [--top N]")
+		flags.PrintDefaults()
+	}
+	if args := parseFlags(flags, args); len(args) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: unexpected argument %s\n", args[0])
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if *format != "md" {
+		fmt.Fprintf(os.Stderr, "Error: unknown report format %q (want md)\n", *format)
+		os.Exit(1)
+	}
+	checkCloc()
+
+	if err := printReport(*base, *head, *top); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// printReport scans head and where it branched from base, counts the diff
+// between them and prints the report. Comparing with the merge base leaves
+// out changes made on base since.
+func printReport(base, head string, top int) error {
+	mergeBase, err := cloc.MergeBase(base, head)
+	if err != nil {
+		return err
+	}
+	baseResult, err := cloc.Count(mergeBase, true)
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", base, err)
+	}
+	headResult, err := cloc.Count(head, true)
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", head, err)
+	}
+	diff := cloc.Diff{Kind: cloc.MergeBaseDiff, Base: base}
+	changes, err := cloc.GitDiff(head, true, diff, report.LanguageOf(baseResult, headResult))
+	if err != nil {
+		return err
+	}
+
+	r := report.Report{
+		BaseRef:  base,
+		HeadRef:  head,
+		Base:     baseResult,
+		Head:     headResult,
+		Changes:  changes,
+		TopFiles: top,
+	}
+	fmt.Print(r.Markdown())
+	return nil
+}
@@ -0,0 +1,208 @@
+// Package report renders the changes between two scans as Markdown, for
+// pull request comments
+package report
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/devin/gloc/cloc"
+)
+
+// reportPieSlices is how many languages the share pie names before the rest
+// are summed as "Other"
+const reportPieSlices = 8
+
+// Report compares the scans of a pull request's base and head, for posting
+// as a comment
+type Report struct {
+	BaseRef  string
+	HeadRef  string
+	Base     *cloc.Result
+	Head     *cloc.Result
+	Changes  *cloc.Changes // Lines changed since the merge base
+	TopFiles int           // Changed files listed
+}
+
+// LanguageOf returns a function naming the language of a path in either
+// scan, for counting the diff between them
+func LanguageOf(base, head *cloc.Result) func(string) string {
+	langs := make(map[string]string)
+	for _, r := range []*cloc.Result{base, head} {
+		for name, files := range r.Files {
+			for _, file := range files {
+				langs[filepath.Clean(file.Path)] = name
+			}
+		}
+	}
+	return func(path string) string {
+		return langs[path]
+	}
+}
+
+// Markdown renders the report: a summary, the languages introduced, the
+// change per language, the most changed files and a pie of the head's
+// languages
+func (r Report) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Lines of code: `%s` → `%s`\n\n", r.BaseRef, r.HeadRef)
+	b.WriteString(r.Changes.Summary() + ".\n")
+
+	if introduced := r.introducedLanguages(); len(introduced) > 0 {
+		b.WriteString("\nNew languages: " + strings.Join(introduced, ", ") + "\n")
+	}
+
+	if rows := r.languageRows(); len(rows) > 1 {
+		b.WriteString("\n### Languages\n\n")
+		b.WriteString(MarkdownTable([]string{"Language", "Files", "Code", "Δ Code", "Comment", "Δ Comment", "Blank", "Δ Blank"}, rows))
+	}
+
+	if rows := r.fileRows(); len(rows) > 0 {
+		title := "Changed files"
+		if len(r.Changes.Files) > len(rows) {
+			title = fmt.Sprintf("Top %d of %d changed files", len(rows), len(r.Changes.Files))
+		}
+		b.WriteString("\n### " + title + "\n\n")
+		b.WriteString(MarkdownTable([]string{"File", "+Code", "−Code", "+Comment", "−Comment", "+Blank", "−Blank"}, rows))
+	}
+
+	if len(r.Head.Languages) > 0 {
+		b.WriteString("\n### Language share\n\n")
+		b.WriteString(r.sharePie())
+	}
+	return b.String()
+}
+
+// formatDelta formats a change in a count with its sign, or "" if none
+func formatDelta(delta int) string {
+	switch {
+	case delta > 0:
+		return "+" + strconv.Itoa(delta)
+	case delta < 0:
+		return "−" + strconv.Itoa(-delta)
+	}
+	return ""
+}
+
+// reportLanguages lists the head's languages in its order, then those only
+// the base has
+func (r Report) reportLanguages() []string {
+	var names []string
+	inHead := make(map[string]bool)
+	for _, lang := range r.Head.Languages {
+		names = append(names, lang.Name)
+		inHead[lang.Name] = true
+	}
+	for _, lang := range r.Base.Languages {
+		if !inHead[lang.Name] {
+			names = append(names, lang.Name)
+		}
+	}
+	return names
+}
+
+// languageStats returns the stats of a language in a scan, zero if absent
+func languageStats(result *cloc.Result, name string) cloc.LanguageStats {
+	for _, lang := range result.Languages {
+		if lang.Name == name {
+			return lang
+		}
+	}
+	return cloc.LanguageStats{Name: name}
+}
+
+// languageRows lists the head's counts and their change from the base for
+// every language that changed, then the totals
+func (r Report) languageRows() [][]string {
+	row := func(name string, base, head cloc.LanguageStats) []string {
+		return []string{
+			name,
+			strconv.Itoa(head.Files),
+			strconv.Itoa(head.Code), formatDelta(head.Code - base.Code),
+			strconv.Itoa(head.Comment), formatDelta(head.Comment - base.Comment),
+			strconv.Itoa(head.Blank), formatDelta(head.Blank - base.Blank),
+		}
+	}
+
+	var rows [][]string
+	for _, name := range r.reportLanguages() {
+		base, head := languageStats(r.Base, name), languageStats(r.Head, name)
+		base.Name = ""
+		head.Name = ""
+		if base != head {
+			rows = append(rows, row(name, base, head))
+		}
+	}
+	return append(rows, row("**Total**", r.Base.Total, r.Head.Total))
+}
+
+// fileRows lists the most changed files
+func (r Report) fileRows() [][]string {
+	files := r.Changes.Files
+	if r.TopFiles > 0 && len(files) > r.TopFiles {
+		files = files[:r.TopFiles]
+	}
+	rows := make([][]string, 0, len(files))
+	for _, file := range files {
+		rows = append(rows, []string{
+			"`" + file.Path + "`",
+			strconv.Itoa(file.Added.Code), strconv.Itoa(file.Removed.Code),
+			strconv.Itoa(file.Added.Comment), strconv.Itoa(file.Removed.Comment),
+			strconv.Itoa(file.Added.Blank), strconv.Itoa(file.Removed.Blank),
+		})
+	}
+	return rows
+}
+
+// introducedLanguages lists the languages the head has and the base doesn't
+func (r Report) introducedLanguages() []string {
+	var names []string
+	for _, lang := range r.Head.Languages {
+		if languageStats(r.Base, lang.Name).Files == 0 {
+			names = append(names, fmt.Sprintf("**%s** (%d code lines)", lang.Name, lang.Code))
+		}
+	}
+	return names
+}
+
+// sharePie renders the head's code lines by language as a mermaid pie chart
+func (r Report) sharePie() string {
+	var b strings.Builder
+	b.WriteString("```mermaid\n")
+	b.WriteString("pie showData title Code lines at " + strings.ReplaceAll(r.HeadRef, `"`, "") + "\n")
+	other := 0
+	for i, lang := range r.Head.Languages {
+		if i >= reportPieSlices {
+			other += lang.Code
+			continue
+		}
+		fmt.Fprintf(&b, "    %q : %d\n", strings.ReplaceAll(lang.Name, `"`, ""), lang.Code)
+	}
+	if other > 0 {
+		fmt.Fprintf(&b, "    \"Other\" : %d\n", other)
+	}
+	b.WriteString("```\n")
+	return b.String()
+}
+
+// MarkdownTable renders a Markdown table with the first column left-aligned
+// and the rest right-aligned
+func MarkdownTable(headers []string, rows [][]string) string {
+	var b strings.Builder
+	b.WriteString("| " + strings.Join(headers, " | ") + " |\n")
+	b.WriteString("|")
+	for i := range headers {
+		if i == 0 {
+			b.WriteString(" --- |")
+		} else {
+			b.WriteString(" ---: |")
+		}
+	}
+	b.WriteString("\n")
+	for _, row := range rows {
+		b.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/cloc"
+)
+
+// ageBarWidth is the width of the stacked code age bars
+const ageBarWidth = 10
+
+// ageShades draw each age bucket in the stacked bars, newest first
+var ageShades = []string{"█", "▓", "▒", "░", "·"}
+
+// AgesMsg is the message returned when the file ages have been read
+type AgesMsg struct {
+	Times map[string]time.Time
+	Err   error
+}
+
+// LoadAges reads when each file was last changed from the git history
+func LoadAges(path string, isGit bool) tea.Cmd {
+	return func() tea.Msg {
+		times, err := cloc.GitFileTimes(path, isGit)
+		return AgesMsg{Times: times, Err: err}
+	}
+}
+
+// handleAges installs the file ages. Outside a git repository there are
+// none, and the age columns stay empty.
+func (m *Model) handleAges(msg AgesMsg) {
+	if msg.Err != nil {
+		return
+	}
+	m.FileTimes = msg.Times
+	m.rederive()
+}
+
+// markAges sets when each file of the full scan was last changed
+func (m *Model) markAges() {
+	if m.FileTimes == nil {
+		return
+	}
+	for _, files := range m.FullResult.Files {
+		for i := range files {
+			files[i].Modified = m.FileTimes[filepath.Clean(strings.TrimPrefix(files[i].Path, "./"))]
+		}
+	}
+}
+
+// ageBar renders code lines by age bucket as a stacked bar of width cells,
+// or nothing without ages
+func ageBar(ageCode []int, width int) string {
+	total := 0
+	for _, code := range ageCode {
+		total += code
+	}
+	if total == 0 {
+		return ""
+	}
+
+	// Round the running total so the segments always add up to width
+	var b strings.Builder
+	drawn, sum := 0, 0
+	for i, code := range ageCode {
+		sum += code
+		end := (sum*width + total/2) / total
+		b.WriteString(strings.Repeat(ageShades[i], end-drawn))
+		drawn = end
+	}
+	return b.String()
+}
+
+// ageLegend explains the shades of the age bars
+func ageLegend() string {
+	parts := make([]string, len(cloc.AgeBuckets))
+	for i, name := range cloc.AgeBuckets {
+		parts[i] = ageShades[i] + " " + name
+	}
+	return strings.Join(parts, "  ")
+}
+
+// formatAge formats the time since a file last changed, or nothing if unknown
+func formatAge(modified time.Time) string {
+	if modified.IsZero() {
+		return ""
+	}
+	days := int(time.Since(modified).Hours() / 24)
+	switch {
+	case days < 1:
+		return "today"
+	case days < 14:
+		return fmt.Sprintf("%dd", days)
+	case days < 60:
+		return fmt.Sprintf("%dw", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo", days/30)
+	}
+	return fmt.Sprintf("%.1fy", float64(days)/365)
+}
@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/report"
+)
+
+// Ways of attributing lines to authors
+const (
+	blameMode   = "blame"
+	numstatMode = "numstat"
+)
+
+// AuthorsMsg is the message returned when lines have been attributed to authors
+type AuthorsMsg struct {
+	Authorship cloc.Authorship
+	Err        error
+}
+
+// authorRow is a row of the authors view: an author, or one of their
+// languages or directories when expanded
+type authorRow struct {
+	Author int    // Index into Model.Authors
+	Share  string // Language or directory, empty for the author row
+}
+
+// LoadAuthors attributes the lines of files to their authors
+func LoadAuthors(files []string, path string, isGit bool, mode string, workers int) tea.Cmd {
+	return func() tea.Msg {
+		if mode == numstatMode {
+			authorship, err := cloc.GitNumstat(path, isGit)
+			return AuthorsMsg{Authorship: authorship, Err: err}
+		}
+		return AuthorsMsg{Authorship: cloc.GitBlame(files, path, isGit, workers)}
+	}
+}
+
+// parseAuthorMode validates the configured attribution mode
+func parseAuthorMode(mode string) (string, error) {
+	switch mode {
+	case "", blameMode:
+		return blameMode, nil
+	case numstatMode:
+		return numstatMode, nil
+	}
+	return "", fmt.Errorf("unknown authors mode %q (want blame or numstat)", mode)
+}
+
+// topDir returns the top-level directory of a file, or "." for files at the
+// top of the scanned path
+func (m Model) topDir(file cloc.FileInfo) string {
+	dir, _, ok := strings.Cut(filepath.ToSlash(m.relativePath(file.Path)), "/")
+	if !ok {
+		return "."
+	}
+	return dir + "/"
+}
+
+// showAuthors toggles the authors view, attributing lines the first time it
+// is shown
+func (m *Model) showAuthors() tea.Cmd {
+	if !m.toggleReport(AuthorView) {
+		return nil
+	}
+	return m.loadAuthors()
+}
+
+// loadAuthors starts attributing lines unless that is done or under way
+func (m *Model) loadAuthors() tea.Cmd {
+	if m.Authorship != nil || m.LoadingAuthors {
+		return nil
+	}
+	m.LoadingAuthors = true
+	var files []string
+	for _, langFiles := range m.FullResult.Files {
+		for _, file := range langFiles {
+			files = append(files, file.Path)
+		}
+	}
+	return LoadAuthors(files, m.TargetPath, m.IsGit, m.AuthorMode, m.AuthorWorkers)
+}
+
+// handleAuthors installs the attributed lines and sums them by author
+func (m *Model) handleAuthors(msg AuthorsMsg) {
+	m.LoadingAuthors = false
+	if msg.Err != nil {
+		m.StatusMsg = "Reading git history failed: " + msg.Err.Error()
+		if m.Mode == AuthorView {
+			m.Mode = LanguageView
+		}
+		return
+	}
+	m.Authorship = msg.Authorship
+	if m.Result != nil {
+		m.Authors = m.Result.Authors(m.Authorship, m.topDir)
+	}
+	m.setAuthorCursor(m.AuthorCursor)
+}
+
+// authorShares returns an author's languages or directories, as shown
+func (m Model) authorShares(a cloc.Author) []cloc.LineShare {
+	if m.AuthorsByDir {
+		return a.Dirs
+	}
+	return a.Languages
+}
+
+// authorRows lists the authors, each followed by their languages or
+// directories when expanded
+func (m Model) authorRows() []authorRow {
+	rows := make([]authorRow, 0, len(m.Authors))
+	for i, a := range m.Authors {
+		rows = append(rows, authorRow{Author: i})
+		if m.ExpandedAuthors[a.Name] {
+			for _, s := range m.authorShares(a) {
+				rows = append(rows, authorRow{Author: i, Share: s.Name})
+			}
+		}
+	}
+	return rows
+}
+
+// toggleAuthorExpanded shows or hides the breakdown of the author under the cursor
+func (m *Model) toggleAuthorExpanded() {
+	rows := m.authorRows()
+	if m.AuthorCursor >= len(rows) {
+		return
+	}
+	row := rows[m.AuthorCursor]
+	name := m.Authors[row.Author].Name
+	m.ExpandedAuthors[name] = !m.ExpandedAuthors[name]
+	for i, r := range m.authorRows() {
+		if r.Author == row.Author && r.Share == "" {
+			m.setAuthorCursor(i)
+			break
+		}
+	}
+}
+
+// toggleAuthorsByDir switches the breakdown between languages and directories
+func (m *Model) toggleAuthorsByDir() {
+	m.AuthorsByDir = !m.AuthorsByDir
+	rows := m.authorRows()
+	if m.AuthorCursor < len(rows) {
+		// Stay on the same author, whose breakdown rows just changed
+		author := rows[m.AuthorCursor].Author
+		for i, r := range rows {
+			if r.Author == author && r.Share == "" {
+				m.AuthorCursor = i
+				break
+			}
+		}
+	}
+	m.setAuthorCursor(m.AuthorCursor)
+}
+
+// setAuthorCursor moves the author cursor, keeping it on screen
+func (m *Model) setAuthorCursor(i int) {
+	m.AuthorCursor = min(max(i, 0), max(len(m.authorRows())-1, 0))
+	visibleRows := m.VisibleRows()
+	if m.AuthorCursor < m.AuthorScrollOffset {
+		m.AuthorScrollOffset = m.AuthorCursor
+	} else if m.AuthorCursor >= m.AuthorScrollOffset+visibleRows {
+		m.AuthorScrollOffset = m.AuthorCursor - visibleRows + 1
+	}
+}
+
+// clickAuthor selects the clicked row; double-clicking expands it
+func (m *Model) clickAuthor(row int) {
+	idx := m.AuthorScrollOffset + row
+	if idx >= len(m.authorRows()) {
+		return
+	}
+	doubleClick := idx == m.AuthorCursor && idx == m.lastClickRow && time.Since(m.lastClickTime) < doubleClickTime
+	m.AuthorCursor = idx
+	m.lastClickRow = idx
+	m.lastClickTime = time.Now()
+	if doubleClick {
+		m.toggleAuthorExpanded()
+		m.lastClickRow = -1
+	}
+}
+
+// authoredLines returns the lines attributed to any author
+func (m Model) authoredLines() int {
+	total := 0
+	for _, a := range m.Authors {
+		total += a.Lines
+	}
+	return total
+}
+
+// authorHead renders the title above the author table
+func (m Model) authorHead() string {
+	label := "by language"
+	if m.AuthorsByDir {
+		label = "by directory"
+	}
+	if m.AuthorMode == numstatMode {
+		label += ", lines added"
+	}
+	return TitleStyle.Render(fmt.Sprintf(" 👤 Authors - %s ", m.TargetPath)) + " " + HelpStyle.Render(label) + "\n\n"
+}
+
+func (m Model) renderAuthorView(b *strings.Builder) {
+	b.WriteString(m.authorHead())
+
+	switch {
+	case m.Authorship == nil:
+		b.WriteString(HelpStyle.Render("  Reading git history…"))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	case len(m.Authors) == 0:
+		b.WriteString(HelpStyle.Render("  No lines could be attributed to an author"))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	}
+
+	t, rowCount := m.authorTable()
+	b.WriteString(t.Render())
+	b.WriteString("\n")
+
+	// Pad with empty lines if needed
+	for i := rowCount; i < m.VisibleRows(); i++ {
+		b.WriteString("\n")
+	}
+}
+
+// authorTable builds the table for the visible window of the authors view.
+// The breakdown column is left out when it wouldn't fit.
+func (m Model) authorTable() (*table.Table, int) {
+	const numWidth = 9
+	const numCols = 3
+
+	widest := len("Author") + 6
+	for _, a := range m.Authors {
+		widest = max(widest, lipgloss.Width(a.Name)+6)
+		if m.ExpandedAuthors[a.Name] {
+			for _, s := range m.authorShares(a) {
+				widest = max(widest, lipgloss.Width(s.Name)+8)
+			}
+		}
+	}
+	nameWidth, breakdownWidth := m.fitColumns(fixedWidth(slices.Repeat([]int{numWidth}, numCols)...), widest, 12, 16)
+	showBreakdown := breakdownWidth > 0
+	nameSpace := cellSpace(nameWidth)
+
+	headers := []string{"Author", "Lines", "Files", "% Lines"}
+	if showBreakdown {
+		headers = append(headers, "Languages")
+		if m.AuthorsByDir {
+			headers[len(headers)-1] = "Directories"
+		}
+	}
+
+	all := m.authorRows()
+	total := m.authoredLines()
+	endIdx := min(m.AuthorScrollOffset+m.VisibleRows(), len(all))
+	var rows [][]string
+	for i := m.AuthorScrollOffset; i < endIdx; i++ {
+		row := all[i]
+		author := m.Authors[row.Author]
+
+		cursor := "  "
+		if i == m.AuthorCursor {
+			cursor = CursorStyle.Render("▶ ")
+		}
+
+		var cells []string
+		if row.Share != "" {
+			lines := 0
+			for _, s := range m.authorShares(author) {
+				if s.Name == row.Share {
+					lines = s.Lines
+				}
+			}
+			name := ansi.Truncate(row.Share, nameSpace-4, "…")
+			if !m.AuthorsByDir {
+				name = colorDot(row.Share) + " " + ansi.Truncate(row.Share, nameSpace-6, "…")
+			}
+			cells = []string{cursor + "  " + name, strconv.Itoa(lines), "", formatPercent(share(lines, author.Lines))}
+		} else {
+			marker := "▸ "
+			if m.ExpandedAuthors[author.Name] {
+				marker = "▾ "
+			}
+			cells = []string{
+				cursor + HelpStyle.Render(marker) + ansi.Truncate(author.Name, nameSpace-4, "…"),
+				strconv.Itoa(author.Lines),
+				strconv.Itoa(author.Files),
+				formatPercent(share(author.Lines, total)),
+			}
+		}
+		if showBreakdown {
+			breakdown := ""
+			if row.Share == "" {
+				width := cellSpace(breakdownWidth)
+				breakdown = shareBreakdown(m.authorShares(author), author.Lines, width, !m.AuthorsByDir)
+			}
+			cells = append(cells, breakdown)
+		}
+		rows = append(rows, cells)
+	}
+
+	numericStyles := []lipgloss.Style{CodeStyle, FilesStyle, TotalStyle}
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers(headers...).
+		Rows(rows...).
+		Width(m.ContentWidth()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			width := numWidth
+			switch col {
+			case 0:
+				width = nameWidth
+			case numCols + 1:
+				width = breakdownWidth
+			}
+			if row == table.HeaderRow {
+				return HeaderStyle.Align(lipgloss.Center).Width(width)
+			}
+			if col == 0 || col == numCols+1 {
+				return lipgloss.NewStyle().Width(width)
+			}
+			return numericStyles[col-1].Align(lipgloss.Right).Width(width)
+		})
+
+	return t, len(rows)
+}
+
+// yankAuthors copies the authors view as a Markdown table
+func (m Model) yankAuthors() tea.Cmd {
+	label := "Languages"
+	if m.AuthorsByDir {
+		label = "Directories"
+	}
+	headers := []string{"Author", "Lines", "Files", label}
+	var rows [][]string
+	for _, a := range m.Authors {
+		rows = append(rows, []string{
+			a.Name,
+			strconv.Itoa(a.Lines),
+			strconv.Itoa(a.Files),
+			shareBreakdown(m.authorShares(a), a.Lines, 0, false),
+		})
+	}
+	return CopyToClipboard(report.MarkdownTable(headers, rows), "table")
+}
@@ -0,0 +1,523 @@
+package ui
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/colors"
+	"github.com/devin/gloc/config"
+)
+
+// Buckets of files not covered by the configured buckets or CODEOWNERS
+const (
+	uncategorized = "uncategorized"
+	unowned       = "(unowned)"
+)
+
+// bucketRow is a row of the bucket view: a bucket, or one of its languages
+// when the bucket is expanded
+type bucketRow struct {
+	Bucket   int
+	Language string
+}
+
+// parseBuckets validates the configured buckets
+func parseBuckets(buckets []config.Bucket) ([]config.Bucket, error) {
+	for _, b := range buckets {
+		if b.Name == "" {
+			return nil, fmt.Errorf("bucket without a name")
+		}
+		for _, pattern := range b.Paths {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("bucket %q: bad path pattern %q", b.Name, pattern)
+			}
+		}
+	}
+	return buckets, nil
+}
+
+// bucketOf returns the name of the first bucket with a pattern matching the file
+func (m Model) bucketOf(file cloc.FileInfo) string {
+	rel := filepath.ToSlash(m.relativePath(file.Path))
+	for _, b := range m.BucketConfig {
+		for _, pattern := range b.Paths {
+			if cloc.MatchGlob(pattern, rel) {
+				return b.Name
+			}
+		}
+	}
+	return uncategorized
+}
+
+// deriveBuckets sorts the displayed files into buckets of the current kind
+func (m *Model) deriveBuckets() {
+	switch m.BucketKind {
+	case DirBuckets:
+		m.Buckets = m.Result.Buckets(nil, func(file cloc.FileInfo) []string {
+			return []string{m.topDir(file)}
+		})
+		sort.SliceStable(m.Buckets, func(i, j int) bool {
+			return m.Buckets[i].Total.Code > m.Buckets[j].Total.Code
+		})
+	case OwnerBuckets:
+		if m.Result.OwnersFile == "" {
+			m.Buckets = nil
+			return
+		}
+		m.Buckets = m.Result.Buckets([]string{unowned}, func(file cloc.FileInfo) []string {
+			if len(file.Owners) == 0 {
+				return []string{unowned}
+			}
+			return file.Owners
+		})
+		// Unowned files first, then teams by the code they maintain
+		sort.SliceStable(m.Buckets, func(i, j int) bool {
+			a, b := m.Buckets[i], m.Buckets[j]
+			if (a.Name == unowned) != (b.Name == unowned) {
+				return a.Name == unowned
+			}
+			return a.Total.Code > b.Total.Code
+		})
+	default:
+		// Separated submodules are buckets of their own, ahead of the configured ones
+		submodules := m.submoduleBuckets()
+		if len(m.BucketConfig) == 0 && len(submodules) == 0 {
+			m.Buckets = nil
+			return
+		}
+		names := make([]string, 0, len(submodules)+len(m.BucketConfig)+1)
+		for _, sub := range m.FullResult.Submodules {
+			if label, ok := submodules[sub.Name]; ok {
+				names = append(names, label)
+			}
+		}
+		for _, b := range m.BucketConfig {
+			names = append(names, b.Name)
+		}
+		m.Buckets = m.Result.Buckets(append(names, uncategorized), func(file cloc.FileInfo) []string {
+			if label, ok := submodules[file.Submodule]; ok {
+				return []string{label}
+			}
+			return []string{m.bucketOf(file)}
+		})
+	}
+}
+
+// bucketLabel names what the buckets of the current kind are
+func (m Model) bucketLabel() string {
+	switch m.BucketKind {
+	case OwnerBuckets:
+		return "Owner"
+	case DirBuckets:
+		return "Directory"
+	}
+	return "Bucket"
+}
+
+// bucketRows lists the buckets, each followed by its languages when expanded
+func (m Model) bucketRows() []bucketRow {
+	rows := make([]bucketRow, 0, len(m.Buckets))
+	for i, b := range m.Buckets {
+		rows = append(rows, bucketRow{Bucket: i})
+		if m.ExpandedBuckets[b.Name] {
+			for _, lang := range b.Languages {
+				rows = append(rows, bucketRow{Bucket: i, Language: lang.Name})
+			}
+		}
+	}
+	return rows
+}
+
+// selectedBucketRow returns the row under the bucket cursor
+func (m Model) selectedBucketRow() (bucketRow, bool) {
+	rows := m.bucketRows()
+	if m.BucketCursor >= len(rows) {
+		return bucketRow{}, false
+	}
+	return rows[m.BucketCursor], true
+}
+
+// selectedBucket returns the bucket whose files are listed, if any
+func (m Model) selectedBucket() (cloc.Bucket, bool) {
+	for _, b := range m.Buckets {
+		if b.Name == m.SelectedBucket {
+			return b, true
+		}
+	}
+	return cloc.Bucket{}, false
+}
+
+// selectedBucketTotal returns the totals of the listed bucket, or of its
+// selected language
+func (m Model) selectedBucketTotal() (cloc.LanguageStats, bool) {
+	bucket, ok := m.selectedBucket()
+	if !ok {
+		return cloc.LanguageStats{}, false
+	}
+	if m.SelectedBucketLang == "" {
+		return bucket.Total, true
+	}
+	for _, lang := range bucket.Languages {
+		if lang.Name == m.SelectedBucketLang {
+			lang.Name = bucket.Name + " · " + lang.Name
+			return lang, true
+		}
+	}
+	return cloc.LanguageStats{}, false
+}
+
+// showBuckets toggles the bucket view of the given kind; another kind
+// replaces the one shown
+func (m *Model) showBuckets(kind BucketKind) {
+	switch {
+	case kind == PathBuckets && len(m.BucketConfig) == 0 && m.submoduleBuckets() == nil:
+		m.StatusMsg = "No buckets configured"
+		return
+	case kind == OwnerBuckets && m.Result.OwnersFile == "":
+		m.StatusMsg = "No CODEOWNERS file found"
+		return
+	}
+
+	if m.BucketKind != kind {
+		m.BucketKind = kind
+		m.BucketCursor = 0
+		m.BucketScrollOffset = 0
+		m.deriveBuckets()
+		if m.Mode == BucketView {
+			m.Mode = LanguageView
+		}
+	}
+	m.toggleReport(BucketView)
+}
+
+// openSelectedBucket lists the files of the bucket, or bucket language,
+// under the cursor
+func (m *Model) openSelectedBucket() {
+	row, ok := m.selectedBucketRow()
+	if !ok {
+		return
+	}
+	m.SelectedBucket = m.Buckets[row.Bucket].Name
+	m.SelectedBucketLang = row.Language
+	m.Mode = AllFilesView
+	m.FileFilter = ""
+	m.FileCursor = 0
+	m.FileScrollOffset = 0
+}
+
+// toggleBucketExpanded shows or hides the languages of the bucket under the cursor
+func (m *Model) toggleBucketExpanded() {
+	row, ok := m.selectedBucketRow()
+	if !ok {
+		return
+	}
+	name := m.Buckets[row.Bucket].Name
+	m.ExpandedBuckets[name] = !m.ExpandedBuckets[name]
+	for i, r := range m.bucketRows() {
+		if r.Bucket == row.Bucket && r.Language == "" {
+			m.setBucketCursor(i)
+			break
+		}
+	}
+}
+
+// parentView returns the view that back leads to from the current one
+func (m Model) parentView() ViewMode {
+	if m.Mode == AllFilesView && m.SelectedBucket != "" {
+		return BucketView
+	}
+	return LanguageView
+}
+
+// setBucketCursor moves the bucket cursor, keeping it on screen
+func (m *Model) setBucketCursor(i int) {
+	m.BucketCursor = min(max(i, 0), max(len(m.bucketRows())-1, 0))
+	visibleRows := m.VisibleRows()
+	if m.BucketCursor < m.BucketScrollOffset {
+		m.BucketScrollOffset = m.BucketCursor
+	} else if m.BucketCursor >= m.BucketScrollOffset+visibleRows {
+		m.BucketScrollOffset = m.BucketCursor - visibleRows + 1
+	}
+}
+
+// clickBucket selects the clicked row; double-clicking opens it
+func (m *Model) clickBucket(row int) {
+	idx := m.BucketScrollOffset + row
+	if idx >= len(m.bucketRows()) {
+		return
+	}
+	doubleClick := idx == m.BucketCursor && idx == m.lastClickRow && time.Since(m.lastClickTime) < doubleClickTime
+	m.BucketCursor = idx
+	m.lastClickRow = idx
+	m.lastClickTime = time.Now()
+	if doubleClick {
+		m.openSelectedBucket()
+		m.lastClickRow = -1
+	}
+}
+
+// unownedFiles returns the number of files without an owner
+func (m Model) unownedFiles() int {
+	if m.BucketKind == OwnerBuckets && len(m.Buckets) > 0 && m.Buckets[0].Name == unowned {
+		return m.Buckets[0].Total.Files
+	}
+	return 0
+}
+
+// languageBreakdown lists a bucket's languages by share of its code, as many
+// as fit in width (all of them for 0), with color dots when styled
+func languageBreakdown(b cloc.Bucket, width int, styled bool) string {
+	shares := make([]cloc.LineShare, len(b.Languages))
+	for i, lang := range b.Languages {
+		shares[i] = cloc.LineShare{Name: lang.Name, Lines: lang.Code}
+	}
+	return shareBreakdown(shares, b.Total.Code, width, styled)
+}
+
+// shareBreakdown lists names by their share of total lines, as many as fit in
+// width (all of them for 0), with language color dots when styled
+func shareBreakdown(shares []cloc.LineShare, total, width int, styled bool) string {
+	var parts []string
+	used := 0
+	for i, s := range shares {
+		part := s.Name + " " + formatPercent(share(s.Lines, total))
+		if styled {
+			part = colorDot(s.Name) + " " + part
+		}
+
+		// Leave room to say how many didn't fit
+		more := "+" + strconv.Itoa(len(shares)-i)
+		reserve := 0
+		if i < len(shares)-1 {
+			reserve = len(more) + 2
+		}
+		if width > 0 && len(parts) > 0 && used+lipgloss.Width(part)+reserve > width {
+			parts = append(parts, more)
+			break
+		}
+		used += lipgloss.Width(part) + 2
+		parts = append(parts, part)
+	}
+	breakdown := strings.Join(parts, "  ")
+	if width > 0 {
+		breakdown = ansi.Truncate(breakdown, width, "…")
+	}
+	return breakdown
+}
+
+// share returns part as a fraction of whole, or 0 for an empty whole
+func share(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
+
+// fixedWidth returns the width taken by a report table's fixed columns, with
+// the separator before each and the table's side borders
+func fixedWidth(widths ...int) int {
+	total := 2
+	for _, width := range widths {
+		total += width + 1
+	}
+	return total
+}
+
+// fitColumns sizes a report table's name column to its widest name in the
+// room the fixed columns leave, keeping at least minLast for a last column
+// after it when there is room. It returns the name column's width and the
+// last column's, which is 0 when the last column doesn't fit.
+func (m Model) fitColumns(fixed, widest, minName, minLast int) (name, last int) {
+	room := m.ContentWidth() - fixed
+	name = max(min(widest, room-minLast-1), minName)
+	if last = room - name - 1; last >= minLast {
+		return name, last
+	}
+	return max(min(widest, room), minName), 0
+}
+
+// cellSpace returns the room for text in a column of the given width, as
+// the table wraps cells at the column width less the header padding
+func cellSpace(width int) int {
+	return width - HeaderStyle.GetHorizontalPadding()
+}
+
+// toggleReport switches to a report view, or back to the language view if
+// it is already shown, and reports whether the report is now shown
+func (m *Model) toggleReport(mode ViewMode) bool {
+	m.PreviewFocused = false
+	m.SelectedBucket = ""
+	if m.Mode == mode {
+		m.Mode = LanguageView
+		return false
+	}
+	m.Mode = mode
+	m.FileFilter = ""
+	return true
+}
+
+// colorDot renders a dot in a language's color
+func colorDot(language string) string {
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(colors.GetColor(language))).Render("●")
+}
+
+// bucketHead renders the title above the bucket table
+func (m Model) bucketHead() string {
+	title := fmt.Sprintf(" 📦 Buckets - %s ", m.TargetPath)
+	switch m.BucketKind {
+	case OwnerBuckets:
+		title = fmt.Sprintf(" 👥 Owners - %s ", m.TargetPath)
+	case DirBuckets:
+		title = fmt.Sprintf(" 📁 Directories - %s ", m.TargetPath)
+	}
+	return TitleStyle.Render(title) + "\n\n"
+}
+
+func (m Model) renderBucketView(b *strings.Builder) {
+	b.WriteString(m.bucketHead())
+
+	t, rowCount := m.bucketTable()
+	b.WriteString(t.Render())
+	b.WriteString("\n")
+
+	// Pad with empty lines if needed
+	for i := rowCount; i < m.VisibleRows(); i++ {
+		b.WriteString("\n")
+	}
+}
+
+// bucketTable builds the table for the visible window of the bucket view.
+// The language breakdown column is left out when it wouldn't fit.
+func (m Model) bucketTable() (*table.Table, int) {
+	const numWidth = 9
+	const numCols = 6
+	widths := slices.Repeat([]int{numWidth}, numCols)
+	// Code age, once the history has been read and if the names still fit
+	ageWidth := ageBarWidth + HeaderStyle.GetHorizontalPadding()
+	showAge := m.FileTimes != nil && m.ContentWidth()-fixedWidth(append(widths, ageWidth)...) >= 12
+	if showAge {
+		widths = append(widths, ageWidth)
+	} else {
+		ageWidth = 0
+	}
+
+	widest := len(m.bucketLabel()) + 6
+	for _, bucket := range m.Buckets {
+		widest = max(widest, lipgloss.Width(bucket.Name)+6)
+	}
+	nameWidth, breakdownWidth := m.fitColumns(fixedWidth(widths...), widest, 12, 16)
+	showBreakdown := breakdownWidth > 0
+	nameSpace := cellSpace(nameWidth)
+
+	label := m.bucketLabel()
+	headers := []string{label, "Files", "Blank", "Comment", "Code", "Total", "% Code"}
+	if showAge {
+		headers = append(headers, "Age")
+	}
+	if showBreakdown {
+		headers = append(headers, "Languages")
+	}
+
+	all := m.bucketRows()
+	endIdx := min(m.BucketScrollOffset+m.VisibleRows(), len(all))
+	var rows [][]string
+	for i := m.BucketScrollOffset; i < endIdx; i++ {
+		row := all[i]
+		bucket := m.Buckets[row.Bucket]
+
+		cursor := "  "
+		if i == m.BucketCursor {
+			cursor = CursorStyle.Render("▶ ")
+		}
+
+		var name string
+		stats := bucket.Total
+		files := bucket.Files
+		pct := share(stats.Code, m.Result.Total.Code)
+		if row.Language != "" {
+			files = slices.DeleteFunc(slices.Clone(files), func(f cloc.FileInfo) bool { return f.Language != row.Language })
+			for _, lang := range bucket.Languages {
+				if lang.Name == row.Language {
+					stats = lang
+				}
+			}
+			pct = share(stats.Code, bucket.Total.Code)
+			name = cursor + "  " + colorDot(row.Language) + " " + ansi.Truncate(row.Language, nameSpace-6, "…")
+		} else {
+			marker := "▸ "
+			if m.ExpandedBuckets[bucket.Name] {
+				marker = "▾ "
+			}
+			label := ansi.Truncate(bucket.Name, nameSpace-4, "…")
+			if bucket.Name == unowned {
+				label = StatusMsgStyle.Render(label)
+			}
+			name = cursor + HelpStyle.Render(marker) + label
+		}
+
+		cells := []string{
+			name,
+			strconv.Itoa(stats.Files),
+			strconv.Itoa(stats.Blank),
+			strconv.Itoa(stats.Comment),
+			strconv.Itoa(stats.Code),
+			strconv.Itoa(stats.Code + stats.Comment + stats.Blank),
+			formatPercent(pct),
+		}
+		if showAge {
+			cells = append(cells, ageBar(cloc.AgeCode(files, time.Now()), ageBarWidth))
+		}
+		if showBreakdown {
+			breakdown := ""
+			if row.Language == "" {
+				breakdown = languageBreakdown(bucket, cellSpace(breakdownWidth), true)
+			}
+			cells = append(cells, breakdown)
+		}
+		rows = append(rows, cells)
+	}
+
+	numericStyles := []lipgloss.Style{FilesStyle, BlankStyle, CommentStyle, CodeStyle, TotalStyle, CodeStyle}
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers(headers...).
+		Rows(rows...).
+		Width(m.ContentWidth()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			// The age column, when shown, comes before the breakdown
+			if !showAge && col > numCols {
+				col++
+			}
+			width := numWidth
+			switch col {
+			case 0:
+				width = nameWidth
+			case numCols + 1:
+				width = ageWidth
+			case numCols + 2:
+				width = breakdownWidth
+			}
+			if row == table.HeaderRow {
+				return HeaderStyle.Align(lipgloss.Center).Width(width)
+			}
+			switch col {
+			case 0, numCols + 2:
+				return lipgloss.NewStyle().Width(width)
+			case numCols + 1:
+				return CodeStyle.Width(width)
+			}
+			return numericStyles[col-1].Align(lipgloss.Right).Width(width)
+		})
+
+	return t, len(rows)
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/colors"
+)
+
+// categoryTotal is the summed stats of the languages in one category
+type categoryTotal struct {
+	Name  string
+	Stats cloc.LanguageStats
+}
+
+// parseCategory validates the configured category; empty means all languages
+func parseCategory(category string) (string, error) {
+	if category == "" || category == "all" {
+		return "", nil
+	}
+	if !slices.Contains(colors.Types, category) {
+		return "", fmt.Errorf("unknown category %q (want all, %s)", category, strings.Join(colors.Types, ", "))
+	}
+	return category, nil
+}
+
+// deriveResult rebuilds the displayed result from the full scan, keeping
+// only the languages in the current category and merging groups
+func (m *Model) deriveResult() {
+	if m.FullResult == nil {
+		return
+	}
+	m.markTests()
+	m.markAges()
+	m.Result = m.FullResult.Filter(func(lang cloc.LanguageStats) bool {
+		return m.Category == "" || colors.TypeOf(lang.Name) == m.Category
+	})
+	if m.HideTests {
+		m.Result = m.Result.FilterFiles(func(file cloc.FileInfo) bool { return !file.Test })
+	}
+	m.applySubmodules()
+	if m.ShowGroups {
+		m.Result = m.Result.Group(m.Groups)
+	}
+	m.Metrics = m.Result.Metrics()
+	m.SortLanguages()
+	m.deriveBuckets()
+	if m.Churn != nil {
+		m.Hotspots = m.Result.Hotspots(m.Churn)
+	}
+	if m.Authorship != nil {
+		m.Authors = m.Result.Authors(m.Authorship, m.topDir)
+	}
+}
+
+// categoryTotals sums the full scan by category, skipping empty categories
+func (m Model) categoryTotals() []categoryTotal {
+	if m.FullResult == nil {
+		return nil
+	}
+	totals := make([]categoryTotal, len(colors.Types))
+	for i, name := range colors.Types {
+		totals[i].Name = name
+	}
+	for _, lang := range m.FullResult.Languages {
+		stats := &totals[slices.Index(colors.Types, colors.TypeOf(lang.Name))].Stats
+		stats.Files += lang.Files
+		stats.Blank += lang.Blank
+		stats.Comment += lang.Comment
+		stats.Code += lang.Code
+	}
+	return slices.DeleteFunc(totals, func(t categoryTotal) bool { return t.Stats.Files == 0 })
+}
+
+// cycleCategory switches between all languages and each non-empty category
+func (m *Model) cycleCategory() {
+	cycle := []string{""}
+	for _, total := range m.categoryTotals() {
+		cycle = append(cycle, total.Name)
+	}
+	m.Category = cycle[(slices.Index(cycle, m.Category)+1)%len(cycle)]
+	m.rederive()
+}
+
+// rederive rebuilds the displayed result, keeping the selected language
+// under the cursor when it is still shown, or else its group. Columns that
+// appear with their data are laid out again.
+func (m *Model) rederive() {
+	selected := ""
+	if langs := m.VisibleLanguages(); m.Cursor < len(langs) {
+		selected = langs[m.Cursor].Name
+	}
+	m.deriveResult()
+	m.selectLanguage(selected)
+	m.scrollToCursors()
+	m.clampCursors()
+	m.CalculateColumnWidths()
+}
+
+// summaryHeight returns the lines the category summary takes in the
+// language view, which is only shown when there is more than one category
+func (m Model) summaryHeight() int {
+	if totals := m.categoryTotals(); len(totals) > 1 {
+		return len(totals)
+	}
+	return 0
+}
+
+// renderCategorySummary renders one row per category under the language
+// table, aligned with its columns and marking the current category
+func (m Model) renderCategorySummary() string {
+	columns := m.activeColumns()
+	var rows [][]string
+	for _, total := range m.categoryTotals() {
+		marker := "  ○ "
+		label := HelpStyle.Render(total.Name)
+		if total.Name == m.Category {
+			marker = CursorStyle.Render("  ● ")
+			label = HelpKeyStyle.Render(total.Name)
+		}
+		row := []string{marker + label}
+		for _, col := range columns {
+			value := ""
+			if !col.Derived {
+				value = col.Format(total.Stats, cloc.LanguageMetrics{})
+			}
+			row = append(row, value)
+		}
+		rows = append(rows, row)
+	}
+
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		BorderTop(false).
+		BorderBottom(false).
+		Rows(rows...).
+		Width(m.ContentWidth()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			width := m.langColumnWidth(col)
+			if col == 0 {
+				return lipgloss.NewStyle().Width(width)
+			}
+			if col-1 < len(columns) {
+				return columns[col-1].Style().Faint(true).Align(lipgloss.Right).Width(width)
+			}
+			return lipgloss.NewStyle().Align(lipgloss.Right)
+		})
+	return t.Render()
+}
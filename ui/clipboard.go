@@ -0,0 +1,265 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/report"
+)
+
+// ClipboardMsg is the message returned after copying to the clipboard
+type ClipboardMsg struct {
+	What string
+	Err  error
+}
+
+// Output is the terminal the program renders to. Writes are serialized, so
+// escape sequences sent outside the renderer, like the clipboard's, land
+// between frames rather than inside one.
+type Output struct {
+	*os.File
+	mu sync.Mutex
+}
+
+// TerminalOutput is the program's output, to be passed to tea.WithOutput
+var TerminalOutput = &Output{File: os.Stdout}
+
+func (o *Output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.File.Write(p)
+}
+
+func (o *Output) WriteString(s string) (int, error) {
+	return o.Write([]byte(s))
+}
+
+// CopyToClipboard copies text with an OSC52 escape sequence, which works over
+// SSH, and also through a local clipboard tool when one is available
+func CopyToClipboard(text, what string) tea.Cmd {
+	return func() tea.Msg {
+		seq := osc52.New(text)
+		if os.Getenv("TMUX") != "" {
+			seq = seq.Tmux()
+		} else if os.Getenv("STY") != "" {
+			seq = seq.Screen()
+		}
+		_, err := seq.WriteTo(TerminalOutput)
+
+		if tool := localClipboardTool(); tool != nil {
+			tool.Stdin = strings.NewReader(text)
+			if toolErr := tool.Run(); toolErr == nil {
+				err = nil
+			}
+		}
+		return ClipboardMsg{What: what, Err: err}
+	}
+}
+
+// localClipboardTool returns the command for the local clipboard, if any
+func localClipboardTool() *exec.Cmd {
+	candidates := [][]string{}
+	switch {
+	case runtime.GOOS == "darwin":
+		candidates = append(candidates, []string{"pbcopy"})
+	case os.Getenv("WAYLAND_DISPLAY") != "":
+		candidates = append(candidates, []string{"wl-copy"})
+	case os.Getenv("DISPLAY") != "":
+		candidates = append(candidates,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := exec.LookPath(candidate[0]); err == nil {
+			return exec.Command(candidate[0], candidate[1:]...)
+		}
+	}
+	return nil
+}
+
+// yankSelection copies the selected file path, or the selected language and its counts
+func (m Model) yankSelection() tea.Cmd {
+	if m.isFileList() {
+		file, ok := m.SelectedFile()
+		if !ok {
+			return nil
+		}
+		return CopyToClipboard(m.relativePath(file.Path), "path")
+	}
+
+	if m.Mode == BucketView {
+		row, ok := m.selectedBucketRow()
+		if !ok {
+			return nil
+		}
+		total := m.Buckets[row.Bucket].Total
+		if row.Language != "" {
+			for _, lang := range m.Buckets[row.Bucket].Languages {
+				if lang.Name == row.Language {
+					total = lang
+					total.Name = m.Buckets[row.Bucket].Name + " · " + lang.Name
+				}
+			}
+		}
+		text := fmt.Sprintf("%s: %d files, %d blank, %d comment, %d code, %d lines",
+			total.Name, total.Files, total.Blank, total.Comment, total.Code, total.Code+total.Comment+total.Blank)
+		return CopyToClipboard(text, total.Name)
+	}
+
+	if m.Mode == AuthorView {
+		rows := m.authorRows()
+		if m.AuthorCursor >= len(rows) {
+			return nil
+		}
+		a := m.Authors[rows[m.AuthorCursor].Author]
+		text := fmt.Sprintf("%s: %d lines in %d files (%s)", a.Name, a.Lines, a.Files, shareBreakdown(m.authorShares(a), a.Lines, 0, false))
+		return CopyToClipboard(text, a.Name)
+	}
+
+	if m.Mode == DiffView {
+		return m.yankChange()
+	}
+
+	if m.Mode == HotspotView {
+		if m.HotspotCursor >= len(m.Hotspots) {
+			return nil
+		}
+		return CopyToClipboard(m.relativePath(m.Hotspots[m.HotspotCursor].File.Path), "path")
+	}
+
+	langs := m.VisibleLanguages()
+	if m.Cursor >= len(langs) {
+		return nil
+	}
+	lang := langs[m.Cursor]
+	text := fmt.Sprintf("%s: %d files, %d blank, %d comment, %d code, %d lines",
+		lang.Name, lang.Files, lang.Blank, lang.Comment, lang.Code, lang.Code+lang.Comment+lang.Blank)
+	return CopyToClipboard(text, lang.Name)
+}
+
+// yankView copies every row of the current view as a Markdown table
+func (m Model) yankView() tea.Cmd {
+	if m.Result == nil {
+		return nil
+	}
+	if m.Mode == BucketView {
+		return m.yankBuckets()
+	}
+	if m.Mode == HotspotView {
+		return m.yankHotspots()
+	}
+	if m.Mode == DiffView {
+		return m.yankChanges()
+	}
+	if m.Mode == AuthorView {
+		return m.yankAuthors()
+	}
+
+	var headers []string
+	var rows [][]string
+	if m.isFileList() {
+		headers = []string{"File", "Blank", "Comment", "Code", "Total"}
+		if m.Mode == AllFilesView {
+			headers = []string{"File", "Language", "Blank", "Comment", "Code", "Total"}
+		}
+		if m.FileTimes != nil {
+			headers = append(headers, "Age")
+		}
+		for _, file := range m.VisibleFiles() {
+			row := []string{"`" + m.relativePath(file.Path) + "`"}
+			if m.Mode == AllFilesView {
+				row = append(row, file.Language)
+			}
+			row = append(row,
+				strconv.Itoa(file.Blank),
+				strconv.Itoa(file.Comment),
+				strconv.Itoa(file.Code),
+				strconv.Itoa(file.Code+file.Comment+file.Blank),
+			)
+			if m.FileTimes != nil {
+				row = append(row, formatAge(file.Modified))
+			}
+			rows = append(rows, row)
+		}
+	} else {
+		headers = []string{"Language"}
+		for _, col := range m.activeColumns() {
+			title := col.Title
+			if _, label, ok := strings.Cut(title, "] "); ok {
+				title = label
+			}
+			headers = append(headers, title)
+		}
+		for _, lang := range m.VisibleLanguages() {
+			row := []string{lang.Name}
+			if m.groupOf(lang.Name) != "" {
+				row[0] = "└ " + lang.Name
+			}
+			for _, col := range m.activeColumns() {
+				row = append(row, col.Format(lang, m.Metrics[lang.Name]))
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	total := m.Result.Total
+	if m.activeFilter() != "" || m.Mode == FileView || m.SelectedBucket != "" {
+		total = m.filteredTotals()
+	}
+	totalRow := []string{"**Total**"}
+	if m.Mode == LanguageView {
+		// Derived columns don't sum, so only the line counts are totalled
+		for _, col := range m.activeColumns() {
+			value := ""
+			if !col.Derived {
+				value = col.Format(total, cloc.LanguageMetrics{})
+			}
+			totalRow = append(totalRow, value)
+		}
+	} else {
+		if m.Mode == AllFilesView {
+			totalRow = append(totalRow, "")
+		}
+		totalRow = append(totalRow,
+			strconv.Itoa(total.Blank),
+			strconv.Itoa(total.Comment),
+			strconv.Itoa(total.Code),
+			strconv.Itoa(total.Code+total.Comment+total.Blank),
+		)
+		if m.FileTimes != nil {
+			totalRow = append(totalRow, "")
+		}
+	}
+	rows = append(rows, totalRow)
+
+	return CopyToClipboard(report.MarkdownTable(headers, rows), "table")
+}
+
+// yankBuckets copies the bucket view as a Markdown table
+func (m Model) yankBuckets() tea.Cmd {
+	headers := []string{m.bucketLabel(), "Files", "Blank", "Comment", "Code", "Total", "Languages"}
+	var rows [][]string
+	for _, bucket := range m.Buckets {
+		total := bucket.Total
+		rows = append(rows, []string{
+			bucket.Name,
+			strconv.Itoa(total.Files),
+			strconv.Itoa(total.Blank),
+			strconv.Itoa(total.Comment),
+			strconv.Itoa(total.Code),
+			strconv.Itoa(total.Code + total.Comment + total.Blank),
+			languageBreakdown(bucket, 0, false),
+		})
+	}
+	return CopyToClipboard(report.MarkdownTable(headers, rows), "table")
+}
@@ -0,0 +1,330 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/devin/gloc/cloc"
+)
+
+// langColumn is a numeric column that can be shown in the language table
+type langColumn struct {
+	ID       string // Name used in the config file
+	Title    string
+	Desc     string
+	Sort     SortColumn
+	MinWidth int
+	Derived  bool             // Computed from the files rather than summed, so not totalled
+	Shown    func(Model) bool // Whether there is anything to show, for optional columns
+	Style    func() lipgloss.Style
+	Value    func(cloc.LanguageStats, cloc.LanguageMetrics) float64
+	Format   func(cloc.LanguageStats, cloc.LanguageMetrics) string
+}
+
+// DefaultColumns are the language table columns shown without configuration.
+// The age and test columns are optional, see activeColumns.
+var DefaultColumns = []string{"files", "blank", "comment", "code", "total", "age", "test_code", "prod_code", "test_ratio"}
+
+// langColumns lists every available column in chooser order. Styles are
+// looked up lazily since ApplyTheme replaces them.
+var langColumns = []langColumn{
+	{
+		ID: "files", Title: "[2] Files", Desc: "number of files", Sort: SortByFiles, MinWidth: MinColFiles,
+		Style:  func() lipgloss.Style { return FilesStyle },
+		Value:  func(l cloc.LanguageStats, _ cloc.LanguageMetrics) float64 { return float64(l.Files) },
+		Format: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) string { return strconv.Itoa(l.Files) },
+	},
+	{
+		ID: "blank", Title: "[3] Blank", Desc: "blank lines", Sort: SortByBlank, MinWidth: MinColBlank,
+		Style:  func() lipgloss.Style { return BlankStyle },
+		Value:  func(l cloc.LanguageStats, _ cloc.LanguageMetrics) float64 { return float64(l.Blank) },
+		Format: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) string { return strconv.Itoa(l.Blank) },
+	},
+	{
+		ID: "comment", Title: "[4] Comment", Desc: "comment lines", Sort: SortByComment, MinWidth: MinColComment,
+		Style:  func() lipgloss.Style { return CommentStyle },
+		Value:  func(l cloc.LanguageStats, _ cloc.LanguageMetrics) float64 { return float64(l.Comment) },
+		Format: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) string { return strconv.Itoa(l.Comment) },
+	},
+	{
+		ID: "code", Title: "[5] Code", Desc: "code lines", Sort: SortByCode, MinWidth: MinColCode,
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(l cloc.LanguageStats, _ cloc.LanguageMetrics) float64 { return float64(l.Code) },
+		Format: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) string { return strconv.Itoa(l.Code) },
+	},
+	{
+		ID: "total", Title: "[6] Total", Desc: "all lines", Sort: SortByTotal, MinWidth: MinColCode,
+		Style: func() lipgloss.Style { return TotalStyle },
+		Value: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) float64 {
+			return float64(l.Code + l.Comment + l.Blank)
+		},
+		Format: func(l cloc.LanguageStats, _ cloc.LanguageMetrics) string {
+			return strconv.Itoa(l.Code + l.Comment + l.Blank)
+		},
+	},
+	{
+		ID: "avg_code", Title: "Avg code", Desc: "code lines per file", Sort: SortByAvgCode, Derived: true, MinWidth: MinColCode,
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.AvgCode },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return fmt.Sprintf("%.1f", lm.AvgCode) },
+	},
+	{
+		ID: "comment_ratio", Title: "Comment %", Desc: "comments as a share of code and comments", Sort: SortByCommentRatio, Derived: true, MinWidth: MinColComment,
+		Style:  func() lipgloss.Style { return CommentStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.CommentRatio },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return formatPercent(lm.CommentRatio) },
+	},
+	{
+		ID: "percent", Title: "% Code", Desc: "share of all code lines", Sort: SortByPercent, Derived: true, MinWidth: MinColCode,
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.Share },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return formatPercent(lm.Share) },
+	},
+	{
+		ID: "max_lines", Title: "Max lines", Desc: "lines in the largest file", Sort: SortByMaxLines, Derived: true, MinWidth: MinColCode,
+		Style:  func() lipgloss.Style { return TotalStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return float64(lm.MaxLines) },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return strconv.Itoa(lm.MaxLines) },
+	},
+	{
+		ID: "median_lines", Title: "Median lines", Desc: "median lines per file", Sort: SortByMedianLines, Derived: true, MinWidth: MinColCode,
+		Style: func() lipgloss.Style { return TotalStyle },
+		Value: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.MedianLines },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string {
+			return strconv.FormatFloat(lm.MedianLines, 'f', -1, 64)
+		},
+	},
+	{
+		ID: "bytes", Title: "Size", Desc: "bytes on disk", Sort: SortByBytes, Derived: true, MinWidth: MinColCode,
+		Style:  func() lipgloss.Style { return FilesStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return float64(lm.Bytes) },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return formatBytes(lm.Bytes) },
+	},
+	{
+		ID: "test_code", Title: "Test code", Desc: "code lines in test files", Sort: SortByTestCode, Derived: true, MinWidth: MinColCode,
+		Shown:  Model.hasTests,
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return float64(lm.TestCode) },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return strconv.Itoa(lm.TestCode) },
+	},
+	{
+		ID: "prod_code", Title: "Prod code", Desc: "code lines outside test files", Sort: SortByProdCode, Derived: true, MinWidth: MinColCode,
+		Shown:  Model.hasTests,
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(l cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return float64(l.Code - lm.TestCode) },
+		Format: func(l cloc.LanguageStats, lm cloc.LanguageMetrics) string { return strconv.Itoa(l.Code - lm.TestCode) },
+	},
+	{
+		ID: "test_ratio", Title: "Test ratio", Desc: "test code lines per production code line", Sort: SortByTestRatio, Derived: true, MinWidth: MinColFiles,
+		Shown:  Model.hasTests,
+		Style:  func() lipgloss.Style { return FilesStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.TestRatio },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return formatRatio(lm) },
+	},
+	{
+		ID: "age", Title: "Age", Desc: "code by last change: " + ageLegend(), Sort: SortByAge, Derived: true, MinWidth: ageBarWidth + 2,
+		Shown:  func(m Model) bool { return m.FileTimes != nil },
+		Style:  func() lipgloss.Style { return CodeStyle },
+		Value:  func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) float64 { return lm.Active },
+		Format: func(_ cloc.LanguageStats, lm cloc.LanguageMetrics) string { return ageBar(lm.AgeCode, ageBarWidth) },
+	},
+}
+
+// lookupColumn returns the column with the given config name
+func lookupColumn(id string) (langColumn, bool) {
+	for _, col := range langColumns {
+		if col.ID == id {
+			return col, true
+		}
+	}
+	return langColumn{}, false
+}
+
+// columnBySort returns the column sorted by col
+func columnBySort(col SortColumn) (langColumn, bool) {
+	for _, c := range langColumns {
+		if c.Sort == col {
+			return c, true
+		}
+	}
+	return langColumn{}, false
+}
+
+// parseColumns validates the configured column names, defaulting to DefaultColumns
+func parseColumns(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return append([]string(nil), DefaultColumns...), nil
+	}
+	for _, id := range ids {
+		if _, ok := lookupColumn(id); !ok {
+			names := make([]string, len(langColumns))
+			for i, col := range langColumns {
+				names[i] = col.ID
+			}
+			return nil, fmt.Errorf("unknown column %q (want one of %s)", id, strings.Join(names, ", "))
+		}
+	}
+	return append([]string(nil), ids...), nil
+}
+
+// activeColumns returns the columns shown in the language table, in order.
+// Optional columns are left out while there is nothing to show in them, and
+// when the table would be too narrow for full headers with them.
+func (m Model) activeColumns() []langColumn {
+	room := m.ContentWidth() - MinColLanguage - 2
+	var cols []langColumn
+	for _, id := range m.LangColumns {
+		if col, ok := lookupColumn(id); ok && col.Shown == nil {
+			room -= columnWidth(col) + 1
+		}
+	}
+	for _, id := range m.LangColumns {
+		col, ok := lookupColumn(id)
+		if !ok {
+			continue
+		}
+		if col.Shown != nil {
+			if !col.Shown(m) || columnWidth(col)+1 > room {
+				continue
+			}
+			room -= columnWidth(col) + 1
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+// columnWidth returns the width a column needs for its full header with a
+// sort arrow
+func columnWidth(col langColumn) int {
+	return max(col.MinWidth, lipgloss.Width(col.Title+" ▼1")+HeaderStyle.GetHorizontalPadding())
+}
+
+// languageValue returns the value of a sort column for a language
+func (m Model) languageValue(lang cloc.LanguageStats, col SortColumn) float64 {
+	if c, ok := columnBySort(col); ok {
+		return c.Value(lang, m.Metrics[lang.Name])
+	}
+	return 0
+}
+
+// formatPercent formats a fraction as a percentage
+func formatPercent(f float64) string {
+	return fmt.Sprintf("%.1f%%", f*100)
+}
+
+// formatRatio formats the test ratio, with a dash for languages without
+// tests and ∞ for those with nothing but tests
+func formatRatio(lm cloc.LanguageMetrics) string {
+	switch {
+	case lm.TestCode == 0:
+		return "-"
+	case lm.TestRatio == 0:
+		return "∞"
+	}
+	return fmt.Sprintf("%.2f", lm.TestRatio)
+}
+
+// formatBytes formats a size with binary units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// toggleColumn hides a shown column, or shows it after the others
+func (m *Model) toggleColumn(id string) {
+	defer m.CalculateColumnWidths()
+	for i, active := range m.LangColumns {
+		if active == id {
+			m.LangColumns = append(m.LangColumns[:i:i], m.LangColumns[i+1:]...)
+			return
+		}
+	}
+	m.LangColumns = append(m.LangColumns[:len(m.LangColumns):len(m.LangColumns)], id)
+}
+
+// handleColumnsKey handles keys while the column chooser is open
+func (m Model) handleColumnsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	k := m.Keys
+	switch {
+	case key.Matches(msg, k.ForceQuit):
+		return m, tea.Quit
+	case key.Matches(msg, k.Columns), key.Matches(msg, k.Back), key.Matches(msg, k.Quit):
+		m.ShowColumns = false
+	case key.Matches(msg, k.Up):
+		m.ColumnCursor = max(m.ColumnCursor-1, 0)
+	case key.Matches(msg, k.Down):
+		m.ColumnCursor = min(m.ColumnCursor+1, len(langColumns)-1)
+	case key.Matches(msg, k.ToggleColumn):
+		m.toggleColumn(langColumns[m.ColumnCursor].ID)
+	case key.Matches(msg, k.Open):
+		// Sort by the highlighted column, showing it first if hidden
+		col := langColumns[m.ColumnCursor]
+		if !m.columnShown(col.ID) {
+			m.toggleColumn(col.ID)
+		}
+		m.handleSort(col.Sort, false)
+		m.ShowColumns = false
+	}
+	return m, nil
+}
+
+// columnShown reports whether a column is in the language table
+func (m Model) columnShown(id string) bool {
+	for _, active := range m.LangColumns {
+		if active == id {
+			return true
+		}
+	}
+	return false
+}
+
+// renderColumnChooser renders the column chooser modal
+func (m Model) renderColumnChooser() string {
+	var b strings.Builder
+	b.WriteString(TitleStyle.Render(" ☰ Columns "))
+	b.WriteString("\n\n")
+
+	for i, col := range langColumns {
+		cursor := "  "
+		if i == m.ColumnCursor {
+			cursor = CursorStyle.Render("▶ ")
+		}
+		check := "[ ]"
+		if m.columnShown(col.ID) {
+			check = HelpKeyStyle.Render("[x]")
+		}
+		title := col.Title
+		if _, label, ok := strings.Cut(title, "] "); ok {
+			title = label
+		}
+		title = m.sortHeader(title, col.Sort, m.LangSort)
+
+		style := NormalRowStyle
+		if i == m.ColumnCursor {
+			style = SelectedRowStyle
+		}
+		b.WriteString(cursor + check + " ")
+		b.WriteString(style.Width(16).Render(title))
+		b.WriteString(HelpStyle.Render(col.Desc))
+		b.WriteString("\n")
+	}
+
+	k := m.Keys
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(fmt.Sprintf("%s toggle • %s sort by column • %s close",
+		firstKey(k.ToggleColumn), firstKey(k.Open), firstKey(k.Back))))
+	return b.String()
+}
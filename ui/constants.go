@@ -7,6 +7,8 @@ const (
 	MinColBlank    = 8
 	MinColComment  = 10
 	MinColCode     = 10
+	// The language column shrinks to this before headers are shortened
+	minColLanguageNarrow = 12
 )
 
 // SortColumn represents which column to sort by
@@ -19,6 +21,17 @@ const (
 	SortByComment
 	SortByName
 	SortByTotal
+	SortByLanguage
+	SortByAvgCode
+	SortByCommentRatio
+	SortByPercent
+	SortByMaxLines
+	SortByMedianLines
+	SortByBytes
+	SortByTestCode
+	SortByProdCode
+	SortByTestRatio
+	SortByAge
 )
 
 // ViewMode represents the current view
@@ -27,4 +40,18 @@ type ViewMode int
 const (
 	LanguageView ViewMode = iota
 	FileView
+	AllFilesView
+	BucketView
+	HotspotView
+	AuthorView
+	DiffView
+)
+
+// BucketKind selects how the bucket view sorts files
+type BucketKind int
+
+const (
+	PathBuckets  BucketKind = iota // By the configured path globs
+	OwnerBuckets                   // By CODEOWNERS
+	DirBuckets                     // By top-level directory
 )
@@ -0,0 +1,350 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/report"
+)
+
+// ChangesMsg is the message returned when a diff has been counted
+type ChangesMsg struct {
+	Changes *cloc.Changes
+	Err     error
+}
+
+// changeRow is a row of the changes view: a language, or one of its files
+// when expanded
+type changeRow struct {
+	Language int // Index into Changes.Languages
+	File     int // Index into Changes.Files, or -1 for the language row
+}
+
+// LoadChanges counts the lines a diff adds and removes by language
+func LoadChanges(path string, isGit bool, diff cloc.Diff, languageOf func(string) string) tea.Cmd {
+	return func() tea.Msg {
+		changes, err := cloc.GitDiff(path, isGit, diff, languageOf)
+		return ChangesMsg{Changes: changes, Err: err}
+	}
+}
+
+// ShowChanges starts in the changes view for a diff, counted once the scan
+// has finished
+func (m *Model) ShowChanges(diff cloc.Diff) {
+	m.Diff = diff
+	m.Mode = DiffView
+}
+
+// toggleChanges toggles the changes view, counting the diff the first time
+// it is shown
+func (m *Model) toggleChanges() tea.Cmd {
+	if !m.toggleReport(DiffView) {
+		return nil
+	}
+	return m.loadChanges()
+}
+
+// loadChanges starts counting the diff unless that is done or under way
+func (m *Model) loadChanges() tea.Cmd {
+	if m.Changes != nil || m.LoadingChanges || m.FullResult == nil {
+		return nil
+	}
+	m.LoadingChanges = true
+	return LoadChanges(m.TargetPath, m.IsGit, m.Diff, m.languageOf())
+}
+
+// languageOf returns a function naming the language of a path as the scan
+// did. Files the scan didn't see, such as deleted ones, take the language of
+// scanned files with the same extension.
+func (m Model) languageOf() func(string) string {
+	byPath := make(map[string]string)
+	byExt := make(map[string]string)
+	for _, lang := range m.FullResult.Languages {
+		for _, file := range m.FullResult.Files[lang.Name] {
+			byPath[filepath.Clean(file.Path)] = lang.Name
+			if ext := filepath.Ext(file.Path); ext != "" && byExt[ext] == "" {
+				byExt[ext] = lang.Name
+			}
+		}
+	}
+	return func(path string) string {
+		if lang, ok := byPath[path]; ok {
+			return lang
+		}
+		return byExt[filepath.Ext(path)]
+	}
+}
+
+// handleChanges installs the counted diff
+func (m *Model) handleChanges(msg ChangesMsg) {
+	m.LoadingChanges = false
+	if msg.Err != nil {
+		m.StatusMsg = "Reading git diff failed: " + msg.Err.Error()
+		if m.Mode == DiffView {
+			m.Mode = LanguageView
+		}
+		return
+	}
+	m.Changes = msg.Changes
+	m.setChangeCursor(m.ChangeCursor)
+}
+
+// changeRows lists the changed languages, each followed by its files when
+// expanded
+func (m Model) changeRows() []changeRow {
+	if m.Changes == nil {
+		return nil
+	}
+	var rows []changeRow
+	for i, lang := range m.Changes.Languages {
+		rows = append(rows, changeRow{Language: i, File: -1})
+		if m.ExpandedChanges[lang.Name] {
+			for j, file := range m.Changes.Files {
+				if file.Language == lang.Name {
+					rows = append(rows, changeRow{Language: i, File: j})
+				}
+			}
+		}
+	}
+	return rows
+}
+
+// openSelectedChange expands the language under the cursor, or opens the
+// file view at the file under the cursor if it is still there
+func (m *Model) openSelectedChange() {
+	rows := m.changeRows()
+	if m.ChangeCursor >= len(rows) {
+		return
+	}
+	row := rows[m.ChangeCursor]
+	if row.File < 0 {
+		m.toggleChangeExpanded()
+		return
+	}
+	path := m.Changes.Files[row.File].Path
+	for _, file := range m.FullResult.Files[m.Changes.Files[row.File].Language] {
+		if filepath.Clean(file.Path) == path {
+			m.openFile(file)
+			return
+		}
+	}
+	m.StatusMsg = m.relativePath(path) + " was deleted"
+}
+
+// toggleChangeExpanded shows or hides the files of the language under the cursor
+func (m *Model) toggleChangeExpanded() {
+	rows := m.changeRows()
+	if m.ChangeCursor >= len(rows) {
+		return
+	}
+	row := rows[m.ChangeCursor]
+	name := m.Changes.Languages[row.Language].Name
+	m.ExpandedChanges[name] = !m.ExpandedChanges[name]
+	for i, r := range m.changeRows() {
+		if r.Language == row.Language && r.File < 0 {
+			m.setChangeCursor(i)
+			break
+		}
+	}
+}
+
+// setChangeCursor moves the changes cursor, keeping it on screen
+func (m *Model) setChangeCursor(i int) {
+	m.ChangeCursor = min(max(i, 0), max(len(m.changeRows())-1, 0))
+	visibleRows := m.VisibleRows()
+	if m.ChangeCursor < m.ChangeScrollOffset {
+		m.ChangeScrollOffset = m.ChangeCursor
+	} else if m.ChangeCursor >= m.ChangeScrollOffset+visibleRows {
+		m.ChangeScrollOffset = m.ChangeCursor - visibleRows + 1
+	}
+}
+
+// clickChange selects the clicked row; double-clicking opens it
+func (m *Model) clickChange(row int) {
+	idx := m.ChangeScrollOffset + row
+	if idx >= len(m.changeRows()) {
+		return
+	}
+	doubleClick := idx == m.ChangeCursor && idx == m.lastClickRow && time.Since(m.lastClickTime) < doubleClickTime
+	m.ChangeCursor = idx
+	m.lastClickRow = idx
+	m.lastClickTime = time.Now()
+	if doubleClick {
+		m.openSelectedChange()
+		m.lastClickRow = -1
+	}
+}
+
+// formatChange formats lines added and removed as "+12 −3", with the
+// removed lines dimmed
+func formatChange(added, removed int, style lipgloss.Style) string {
+	return style.Render("+"+strconv.Itoa(added)) + " " + HelpStyle.Render("−"+strconv.Itoa(removed))
+}
+
+// diffHead renders the title above the changes table, with the summary
+// taking the blank line under it once the diff has been read
+func (m Model) diffHead() string {
+	head := TitleStyle.Render(fmt.Sprintf(" ± Changes - %s ", m.TargetPath)) + " " + HelpStyle.Render(m.Diff.String()) + "\n"
+	if m.Changes == nil || len(m.Changes.Languages) == 0 {
+		return head + "\n"
+	}
+	return head + "  " + ansi.Truncate(m.Changes.Summary(), m.ContentWidth()-2, "…") + "\n"
+}
+
+func (m Model) renderDiffView(b *strings.Builder) {
+	b.WriteString(m.diffHead())
+
+	switch {
+	case m.Changes == nil:
+		b.WriteString(HelpStyle.Render("  Reading git diff…"))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	case len(m.Changes.Languages) == 0:
+		b.WriteString(HelpStyle.Render("  No " + m.Diff.String() + " in counted files"))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	}
+
+	t, rowCount := m.changeTable()
+	b.WriteString(t.Render())
+	b.WriteString("\n")
+
+	// Pad with empty lines if needed
+	for i := rowCount; i < m.VisibleRows(); i++ {
+		b.WriteString("\n")
+	}
+}
+
+// changeTable builds the table for the visible window of the changes view
+func (m Model) changeTable() (*table.Table, int) {
+	const filesWidth = 9
+	const changeWidth = 15
+	fixed := fixedWidth(filesWidth, changeWidth, changeWidth, changeWidth)
+
+	nameWidth := max(m.ContentWidth()-fixed, 16)
+	nameSpace := cellSpace(nameWidth)
+
+	all := m.changeRows()
+	endIdx := min(m.ChangeScrollOffset+m.VisibleRows(), len(all))
+	var rows [][]string
+	for i := m.ChangeScrollOffset; i < endIdx; i++ {
+		row := all[i]
+		lang := m.Changes.Languages[row.Language]
+
+		cursor := "  "
+		if i == m.ChangeCursor {
+			cursor = CursorStyle.Render("▶ ")
+		}
+
+		var name, files string
+		added, removed := lang.Added, lang.Removed
+		if row.File >= 0 {
+			file := m.Changes.Files[row.File]
+			added, removed = file.Added, file.Removed
+			path := m.relativePath(file.Path)
+			if lipgloss.Width(path) > nameSpace-4 {
+				path = "…" + ansi.TruncateLeft(path, lipgloss.Width(path)-(nameSpace-5), "")
+			}
+			name = cursor + "  " + path
+		} else {
+			marker := "▸ "
+			if m.ExpandedChanges[lang.Name] {
+				marker = "▾ "
+			}
+			name = cursor + HelpStyle.Render(marker) + colorDot(lang.Name) + " " + ansi.Truncate(lang.Name, nameSpace-6, "…")
+			files = strconv.Itoa(lang.Files)
+		}
+		rows = append(rows, []string{
+			name,
+			files,
+			formatChange(added.Code, removed.Code, CodeStyle),
+			formatChange(added.Comment, removed.Comment, CommentStyle),
+			formatChange(added.Blank, removed.Blank, BlankStyle),
+		})
+	}
+
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers("Language", "Files", "Code", "Comment", "Blank").
+		Rows(rows...).
+		Width(m.ContentWidth()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			width := changeWidth
+			switch col {
+			case 0:
+				width = nameWidth
+			case 1:
+				width = filesWidth
+			}
+			if row == table.HeaderRow {
+				return HeaderStyle.Align(lipgloss.Center).Width(width)
+			}
+			switch col {
+			case 0:
+				return lipgloss.NewStyle().Width(width)
+			case 1:
+				return FilesStyle.Align(lipgloss.Right).Width(width)
+			}
+			return lipgloss.NewStyle().Align(lipgloss.Right).Width(width)
+		})
+
+	return t, len(rows)
+}
+
+// yankChange copies the language or file under the cursor with its changes
+func (m Model) yankChange() tea.Cmd {
+	rows := m.changeRows()
+	if m.ChangeCursor >= len(rows) {
+		return nil
+	}
+	row := rows[m.ChangeCursor]
+	name := m.Changes.Languages[row.Language].Name
+	added, removed := m.Changes.Languages[row.Language].Added, m.Changes.Languages[row.Language].Removed
+	if row.File >= 0 {
+		file := m.Changes.Files[row.File]
+		name = m.relativePath(file.Path)
+		added, removed = file.Added, file.Removed
+	}
+	text := fmt.Sprintf("%s: +%d −%d code, +%d −%d comment, +%d −%d blank",
+		name, added.Code, removed.Code, added.Comment, removed.Comment, added.Blank, removed.Blank)
+	return CopyToClipboard(text, name)
+}
+
+// yankChanges copies the summary and the changes by language and by file as
+// Markdown tables
+func (m Model) yankChanges() tea.Cmd {
+	if m.Changes == nil {
+		return nil
+	}
+	counts := func(added, removed cloc.LineCounts) []string {
+		return []string{
+			strconv.Itoa(added.Code), strconv.Itoa(removed.Code),
+			strconv.Itoa(added.Comment), strconv.Itoa(removed.Comment),
+			strconv.Itoa(added.Blank), strconv.Itoa(removed.Blank),
+		}
+	}
+	countHeaders := []string{"+Code", "−Code", "+Comment", "−Comment", "+Blank", "−Blank"}
+
+	var langRows, fileRows [][]string
+	for _, lang := range m.Changes.Languages {
+		langRows = append(langRows, append([]string{lang.Name, strconv.Itoa(lang.Files)}, counts(lang.Added, lang.Removed)...))
+	}
+	total := m.Changes.Total
+	langRows = append(langRows, append([]string{"**Total**", strconv.Itoa(total.Files)}, counts(total.Added, total.Removed)...))
+	for _, file := range m.Changes.Files {
+		fileRows = append(fileRows, append([]string{"`" + m.relativePath(file.Path) + "`"}, counts(file.Added, file.Removed)...))
+	}
+
+	text := m.Changes.Summary() + ".\n\n" +
+		report.MarkdownTable(append([]string{"Language", "Files"}, countHeaders...), langRows) + "\n" +
+		report.MarkdownTable(append([]string{"File"}, countHeaders...), fileRows)
+	return CopyToClipboard(text, "changes")
+}
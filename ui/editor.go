@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/cloc"
+)
+
+// EditorFinishedMsg is the message returned when the editor exits
+type EditorFinishedMsg struct {
+	Path    string
+	TempDir string // read-only checkout of a git blob, removed after editing
+	Err     error
+}
+
+// FileRescannedMsg is the message returned when cloc has recounted a single file
+type FileRescannedMsg struct {
+	Path string
+	Info *cloc.FileInfo
+	Err  error
+}
+
+// editorCommand builds the command that opens path at line 1 in $VISUAL or $EDITOR
+func editorCommand(path string) (*exec.Cmd, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		return nil, errors.New("neither $VISUAL nor $EDITOR is set")
+	}
+
+	args := fields[1:]
+	switch filepath.Base(fields[0]) {
+	case "code", "code-insiders", "codium":
+		args = append(args, "--wait", "--goto", path+":1")
+	case "subl", "hx", "zed":
+		args = append(args, path+":1")
+	default:
+		args = append(args, "+1", path)
+	}
+	return exec.Command(fields[0], args...), nil
+}
+
+// OpenInEditor suspends the UI and opens the file in the user's editor.
+// Files from git ref scans are opened from a read-only temp copy of the blob.
+func OpenInEditor(file cloc.FileInfo, ref string, isGit bool) tea.Cmd {
+	path := file.Path
+	tempDir := ""
+	if isGit {
+		content, err := cloc.ReadSource(file.Path, ref, isGit)
+		if err != nil {
+			return func() tea.Msg { return EditorFinishedMsg{Path: file.Path, Err: err} }
+		}
+		tempDir, err = os.MkdirTemp("", "gloc-")
+		if err != nil {
+			return func() tea.Msg { return EditorFinishedMsg{Path: file.Path, Err: err} }
+		}
+		path = filepath.Join(tempDir, filepath.Base(file.Path))
+		if err := os.WriteFile(path, content, 0o444); err != nil {
+			os.RemoveAll(tempDir)
+			return func() tea.Msg { return EditorFinishedMsg{Path: file.Path, Err: err} }
+		}
+	}
+
+	cmd, err := editorCommand(path)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		return func() tea.Msg { return EditorFinishedMsg{Path: file.Path, Err: err} }
+	}
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return EditorFinishedMsg{Path: file.Path, TempDir: tempDir, Err: err}
+	})
+}
+
+// RescanFile recounts a single file with cloc and returns a command
+func RescanFile(path string) tea.Cmd {
+	return func() tea.Msg {
+		info, err := cloc.RunFile(path)
+		return FileRescannedMsg{Path: path, Info: info, Err: err}
+	}
+}
+
+// handleEditorFinished cleans up after the editor and rescans the edited file
+func (m *Model) handleEditorFinished(msg EditorFinishedMsg) tea.Cmd {
+	if msg.TempDir != "" {
+		os.RemoveAll(msg.TempDir)
+	}
+	if msg.Err != nil {
+		m.StatusMsg = "Editor: " + msg.Err.Error()
+		return nil
+	}
+	// Git blobs are read-only, so their counts can't have changed
+	if m.IsGit {
+		return nil
+	}
+	return RescanFile(msg.Path)
+}
+
+// handleFileRescanned applies a single file's new counts to the result
+func (m *Model) handleFileRescanned(msg FileRescannedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.StatusMsg = "Rescan: " + msg.Err.Error()
+		return nil
+	}
+	if m.Result == nil {
+		return nil
+	}
+
+	m.FullResult.UpdateFile(msg.Path, msg.Info)
+	m.deriveResult()
+	m.clampCursors()
+
+	// Refresh the preview with the edited content
+	m.PreviewPath = ""
+	return m.syncPreview()
+}
+
+// clampCursors keeps the cursors within the visible rows after the data changes
+func (m *Model) clampCursors() {
+	if langs := m.VisibleLanguages(); m.Cursor >= len(langs) {
+		m.Cursor = max(len(langs)-1, 0)
+	}
+	if files := m.VisibleFiles(); m.FileCursor >= len(files) {
+		m.FileCursor = max(len(files)-1, 0)
+	}
+	m.BucketCursor = min(m.BucketCursor, max(len(m.bucketRows())-1, 0))
+	m.HotspotCursor = min(m.HotspotCursor, max(len(m.Hotspots)-1, 0))
+	m.AuthorCursor = min(m.AuthorCursor, max(len(m.authorRows())-1, 0))
+	m.ChangeCursor = min(m.ChangeCursor, max(len(m.changeRows())-1, 0))
+	m.ScrollOffset = min(m.ScrollOffset, m.Cursor)
+	m.FileScrollOffset = min(m.FileScrollOffset, m.FileCursor)
+	m.BucketScrollOffset = min(m.BucketScrollOffset, m.BucketCursor)
+	m.HotspotScrollOffset = min(m.HotspotScrollOffset, m.HotspotCursor)
+	m.AuthorScrollOffset = min(m.AuthorScrollOffset, m.AuthorCursor)
+	m.ChangeScrollOffset = min(m.ChangeScrollOffset, m.ChangeCursor)
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/devin/gloc/cloc"
+	"github.com/sahilm/fuzzy"
+)
+
+// newFilterInput creates the text input used for the / filter
+func newFilterInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.Placeholder = "filter"
+	ti.CharLimit = 256
+	ti.PromptStyle = HelpKeyStyle
+	return ti
+}
+
+// activeFilter returns the filter for the current view
+func (m Model) activeFilter() string {
+	if m.Mode == LanguageView {
+		return m.LangFilter
+	}
+	return m.FileFilter
+}
+
+// setActiveFilter sets the filter for the current view and resets the cursor
+func (m *Model) setActiveFilter(filter string) {
+	if m.Mode == LanguageView {
+		m.LangFilter = filter
+		m.Cursor = 0
+		m.ScrollOffset = 0
+	} else {
+		m.FileFilter = filter
+		m.FileCursor = 0
+		m.FileScrollOffset = 0
+	}
+}
+
+// clearFilter removes the filter for the current view and stops editing it
+func (m *Model) clearFilter() {
+	m.Filtering = false
+	m.FilterInput.Blur()
+	m.FilterInput.Reset()
+	m.setActiveFilter("")
+}
+
+// VisibleLanguages returns the languages matching the language filter, in sort
+// order, followed by the members of any expanded group
+func (m Model) VisibleLanguages() []cloc.LanguageStats {
+	if m.Result == nil {
+		return nil
+	}
+	if m.LangFilter == "" {
+		return m.withMembers(m.Result.Languages)
+	}
+
+	names := make([]string, len(m.Result.Languages))
+	for i, lang := range m.Result.Languages {
+		names[i] = lang.Name
+	}
+
+	var langs []cloc.LanguageStats
+	for _, match := range fuzzy.FindNoSort(m.LangFilter, names) {
+		langs = append(langs, m.Result.Languages[match.Index])
+	}
+	return m.withMembers(langs)
+}
+
+// VisibleFiles returns the sorted files of the selected language, or of every
+// language in the all-files view, matching the file filter
+func (m Model) VisibleFiles() []cloc.FileInfo {
+	if m.Result == nil {
+		return nil
+	}
+	var files []cloc.FileInfo
+	if m.Mode == AllFilesView {
+		files = m.SortAllFiles()
+	} else {
+		files = m.SortFiles(m.SelectedLang)
+	}
+	if m.FileFilter == "" {
+		return files
+	}
+
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = m.relativePath(file.Path)
+	}
+
+	var filtered []cloc.FileInfo
+	for _, match := range fuzzy.FindNoSort(m.FileFilter, paths) {
+		filtered = append(filtered, files[match.Index])
+	}
+	return filtered
+}
+
+// relativePath returns the path relative to the scanned target when possible.
+// Paths in a git ref are already relative to the repository root.
+func (m Model) relativePath(path string) string {
+	if m.IsGit {
+		return strings.TrimPrefix(path, "./")
+	}
+	if rel, err := filepath.Rel(m.TargetPath, path); err == nil {
+		return rel
+	}
+	return path
+}
+
+// matchIndexes returns the byte offsets in s matched by the filter pattern
+func matchIndexes(pattern, s string) []int {
+	if pattern == "" {
+		return nil
+	}
+	matches := fuzzy.Find(pattern, []string{s})
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0].MatchedIndexes
+}
+
+// highlightMatches renders s with the characters at the given byte offsets highlighted
+func highlightMatches(s string, indexes []int) string {
+	if len(indexes) == 0 {
+		return s
+	}
+
+	matched := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		matched[i] = true
+	}
+
+	var b strings.Builder
+	for i, r := range s {
+		if matched[i] {
+			b.WriteString(MatchStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// filteredTotals sums the stats of whatever is visible in the current view
+func (m Model) filteredTotals() cloc.LanguageStats {
+	var total cloc.LanguageStats
+	if m.Mode == LanguageView {
+		for _, lang := range m.VisibleLanguages() {
+			// Members are already counted in their group
+			if m.groupOf(lang.Name) != "" {
+				continue
+			}
+			total.Files += lang.Files
+			total.Blank += lang.Blank
+			total.Comment += lang.Comment
+			total.Code += lang.Code
+		}
+		return total
+	}
+	for _, file := range m.VisibleFiles() {
+		total.Files++
+		total.Blank += file.Blank
+		total.Comment += file.Comment
+		total.Code += file.Code
+	}
+	return total
+}
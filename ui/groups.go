@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/colors"
+)
+
+// parseGroups checks the configured language groups, each of which needs a
+// name and at least one language
+func parseGroups(groups map[string][]string) (map[string][]string, error) {
+	for name, langs := range groups {
+		if name == "" {
+			return nil, fmt.Errorf("language group without a name")
+		}
+		if len(langs) == 0 {
+			return nil, fmt.Errorf("language group %q has no languages", name)
+		}
+	}
+	return groups, nil
+}
+
+// dropLanguageGroups leaves out groups named after a scanned language, which
+// would merge into a row indistinguishable from the language's own
+func (m *Model) dropLanguageGroups() {
+	var dropped []string
+	for _, lang := range m.FullResult.Languages {
+		if _, ok := m.Groups[lang.Name]; ok {
+			dropped = append(dropped, lang.Name)
+			delete(m.Groups, lang.Name)
+		}
+	}
+	if len(dropped) == 0 {
+		return
+	}
+	sort.Strings(dropped)
+	m.StatusMsg = "Ignoring groups named after a language: " + strings.Join(dropped, ", ")
+	if len(m.Groups) == 0 {
+		m.ShowGroups = false
+	}
+}
+
+// isGroup reports whether a language row is a merged group
+func (m Model) isGroup(name string) bool {
+	_, ok := m.Result.Members[name]
+	return ok
+}
+
+// groupOf returns the group a language was merged into, or ""
+func (m Model) groupOf(name string) string {
+	for group, members := range m.Result.Members {
+		if slices.ContainsFunc(members, func(l cloc.LanguageStats) bool { return l.Name == name }) {
+			return group
+		}
+	}
+	return ""
+}
+
+// withMembers lists the members of each expanded group after the group
+func (m Model) withMembers(langs []cloc.LanguageStats) []cloc.LanguageStats {
+	if len(m.Result.Members) == 0 {
+		return langs
+	}
+	rows := make([]cloc.LanguageStats, 0, len(langs))
+	for _, lang := range langs {
+		rows = append(rows, lang)
+		if m.Expanded[lang.Name] {
+			rows = append(rows, m.Result.Members[lang.Name]...)
+		}
+	}
+	return rows
+}
+
+// selectLanguage moves the cursor to a language, or to its group when the
+// language is merged into a collapsed group
+func (m *Model) selectLanguage(name string) {
+	m.Cursor = 0
+	group := ""
+	if m.Result != nil {
+		group = m.groupOf(name)
+	}
+	for i, lang := range m.VisibleLanguages() {
+		if lang.Name == name {
+			m.Cursor = i
+			return
+		}
+		if group != "" && lang.Name == group {
+			m.Cursor = i
+		}
+	}
+}
+
+// toggleGroups switches between merged groups and separate languages
+func (m *Model) toggleGroups() {
+	if len(m.Groups) == 0 {
+		m.StatusMsg = "No language groups configured"
+		return
+	}
+	m.ShowGroups = !m.ShowGroups
+	m.rederive()
+}
+
+// toggleExpanded expands or collapses the group under the cursor; on a
+// member row it collapses the member's group
+func (m *Model) toggleExpanded() {
+	langs := m.VisibleLanguages()
+	if m.Cursor >= len(langs) {
+		return
+	}
+	name := langs[m.Cursor].Name
+	if !m.isGroup(name) {
+		name = m.groupOf(name)
+		if name == "" {
+			return
+		}
+	}
+	m.Expanded[name] = !m.Expanded[name]
+	m.selectLanguage(name)
+	m.scrollToCursors()
+}
+
+// languageColor returns a language's color; a group takes the color of its
+// largest member, and a submodule's language that of its files
+func (m Model) languageColor(name string) string {
+	if members := m.Result.Members[name]; len(members) > 0 {
+		largest := members[0]
+		for _, member := range members[1:] {
+			if member.Code > largest.Code {
+				largest = member
+			}
+		}
+		name = largest.Name
+	}
+	if files := m.Result.Files[name]; len(files) > 0 && files[0].Submodule != "" {
+		name = files[0].Language
+	}
+	return colors.GetColor(name)
+}
+
+// languageLabel renders the name cell of a language row: groups get an
+// expand marker and members are indented under their group
+func (m Model) languageLabel(name, highlighted string) string {
+	dot := lipgloss.NewStyle().Foreground(lipgloss.Color(m.languageColor(name))).Render("●")
+	switch {
+	case m.isGroup(name):
+		marker := "▸"
+		if m.Expanded[name] {
+			marker = "▾"
+		}
+		return dot + " " + highlighted + " " + HelpStyle.Render(marker)
+	case m.groupOf(name) != "":
+		return "  " + dot + " " + highlighted
+	}
+	return dot + " " + highlighted
+}
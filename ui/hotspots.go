@@ -0,0 +1,253 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/colors"
+	"github.com/devin/gloc/report"
+)
+
+// defaultSince is the history window churn is counted over by default
+const defaultSince = "1 year ago"
+
+// ChurnMsg is the message returned when the git history has been read
+type ChurnMsg struct {
+	Churn map[string]cloc.Churn
+	Err   error
+}
+
+// LoadChurn reads the churn of every file from the git history
+func LoadChurn(path string, isGit bool, since string) tea.Cmd {
+	return func() tea.Msg {
+		churn, err := cloc.GitChurn(path, isGit, since)
+		return ChurnMsg{Churn: churn, Err: err}
+	}
+}
+
+// parseSince returns the configured history window, where "all" means the
+// whole history
+func parseSince(since string) string {
+	switch since {
+	case "":
+		return defaultSince
+	case "all":
+		return ""
+	}
+	return since
+}
+
+// showHotspots toggles the hotspots view, reading the history the first time
+// it is shown
+func (m *Model) showHotspots() tea.Cmd {
+	if !m.toggleReport(HotspotView) {
+		return nil
+	}
+	return m.loadChurn()
+}
+
+// loadChurn starts reading the history unless it is loaded or loading
+func (m *Model) loadChurn() tea.Cmd {
+	if m.Churn != nil || m.LoadingChurn {
+		return nil
+	}
+	m.LoadingChurn = true
+	return LoadChurn(m.TargetPath, m.IsGit, m.HotspotSince)
+}
+
+// handleChurn installs the churn read from the history and ranks the files
+func (m *Model) handleChurn(msg ChurnMsg) {
+	m.LoadingChurn = false
+	if msg.Err != nil {
+		m.StatusMsg = "Reading git history failed: " + msg.Err.Error()
+		if m.Mode == HotspotView {
+			m.Mode = LanguageView
+		}
+		return
+	}
+	m.Churn = msg.Churn
+	if m.Result != nil {
+		m.Hotspots = m.Result.Hotspots(m.Churn)
+	}
+	m.setHotspotCursor(m.HotspotCursor)
+}
+
+// setHotspotCursor moves the hotspot cursor, keeping it on screen
+func (m *Model) setHotspotCursor(i int) {
+	m.HotspotCursor = min(max(i, 0), max(len(m.Hotspots)-1, 0))
+	visibleRows := m.VisibleRows()
+	if m.HotspotCursor < m.HotspotScrollOffset {
+		m.HotspotScrollOffset = m.HotspotCursor
+	} else if m.HotspotCursor >= m.HotspotScrollOffset+visibleRows {
+		m.HotspotScrollOffset = m.HotspotCursor - visibleRows + 1
+	}
+}
+
+// openSelectedHotspot opens the file view of the hotspot's language with
+// the file selected
+func (m *Model) openSelectedHotspot() {
+	if m.HotspotCursor < len(m.Hotspots) {
+		m.openFile(m.Hotspots[m.HotspotCursor].File)
+	}
+}
+
+// clickHotspot selects the clicked row; double-clicking opens it
+func (m *Model) clickHotspot(row int) {
+	idx := m.HotspotScrollOffset + row
+	if idx >= len(m.Hotspots) {
+		return
+	}
+	doubleClick := idx == m.HotspotCursor && idx == m.lastClickRow && time.Since(m.lastClickTime) < doubleClickTime
+	m.HotspotCursor = idx
+	m.lastClickRow = idx
+	m.lastClickTime = time.Now()
+	if doubleClick {
+		m.openSelectedHotspot()
+		m.lastClickRow = -1
+	}
+}
+
+// sinceLabel describes the history window
+func (m Model) sinceLabel() string {
+	if m.HotspotSince == "" {
+		return "all history"
+	}
+	return "since " + m.HotspotSince
+}
+
+// scoreBar renders a bar of up to width cells for score relative to the
+// highest score, in the file's language color
+func scoreBar(file cloc.FileInfo, score, highest, width int) string {
+	n := 1
+	if highest > 0 {
+		n = max(score*width/highest, 1)
+	}
+	color := lipgloss.Color(colors.GetColor(file.Language))
+	return lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", n))
+}
+
+// hotspotHead renders the title above the hotspot table
+func (m Model) hotspotHead() string {
+	return TitleStyle.Render(fmt.Sprintf(" 🔥 Hotspots - %s ", m.TargetPath)) + " " + HelpStyle.Render(m.sinceLabel()) + "\n\n"
+}
+
+func (m Model) renderHotspotView(b *strings.Builder) {
+	b.WriteString(m.hotspotHead())
+
+	switch {
+	case m.Churn == nil:
+		b.WriteString(HelpStyle.Render("  Reading git history…"))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	case len(m.Hotspots) == 0:
+		b.WriteString(HelpStyle.Render("  No files changed " + m.sinceLabel()))
+		b.WriteString(strings.Repeat("\n", m.VisibleRows()+2))
+		return
+	}
+
+	t, rowCount := m.hotspotTable()
+	b.WriteString(t.Render())
+	b.WriteString("\n")
+
+	// Pad with empty lines if needed
+	for i := rowCount; i < m.VisibleRows(); i++ {
+		b.WriteString("\n")
+	}
+}
+
+// hotspotTable builds the table for the visible window of the hotspots view.
+// The score bar gets whatever width the path leaves, and is left out when
+// that is too little.
+func (m Model) hotspotTable() (*table.Table, int) {
+	const numWidth = 9
+	const numCols = 4
+	widest := len("File") + 6
+	for _, h := range m.Hotspots {
+		widest = max(widest, lipgloss.Width(m.relativePath(h.File.Path))+6)
+	}
+	pathWidth, barWidth := m.fitColumns(fixedWidth(slices.Repeat([]int{numWidth}, numCols)...), widest, 20, 12)
+	showBar := barWidth > 0
+	pathSpace := cellSpace(pathWidth)
+	barSpace := cellSpace(barWidth)
+
+	headers := []string{"File", "Commits", "Changed", "Code", "Score"}
+	if showBar {
+		headers = append(headers, "")
+	}
+
+	endIdx := min(m.HotspotScrollOffset+m.VisibleRows(), len(m.Hotspots))
+	var rows [][]string
+	for i := m.HotspotScrollOffset; i < endIdx; i++ {
+		h := m.Hotspots[i]
+		cursor := "  "
+		if i == m.HotspotCursor {
+			cursor = CursorStyle.Render("▶ ")
+		}
+
+		path := m.relativePath(h.File.Path)
+		if lipgloss.Width(path) > pathSpace-4 {
+			path = "…" + ansi.TruncateLeft(path, lipgloss.Width(path)-(pathSpace-5), "")
+		}
+		cells := []string{
+			cursor + colorDot(h.File.Language) + " " + path,
+			strconv.Itoa(h.Commits),
+			strconv.Itoa(h.Added + h.Deleted),
+			strconv.Itoa(h.File.Code),
+			strconv.Itoa(h.Score),
+		}
+		if showBar {
+			cells = append(cells, scoreBar(h.File, h.Score, m.Hotspots[0].Score, barSpace))
+		}
+		rows = append(rows, cells)
+	}
+
+	numericStyles := []lipgloss.Style{FilesStyle, TotalStyle, CodeStyle, CodeStyle}
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		Headers(headers...).
+		Rows(rows...).
+		Width(m.ContentWidth()).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			width := numWidth
+			switch col {
+			case 0:
+				width = pathWidth
+			case numCols + 1:
+				width = barWidth
+			}
+			if row == table.HeaderRow {
+				return HeaderStyle.Align(lipgloss.Center).Width(width)
+			}
+			if col == 0 || col == numCols+1 {
+				return lipgloss.NewStyle().Width(width)
+			}
+			return numericStyles[col-1].Align(lipgloss.Right).Width(width)
+		})
+
+	return t, len(rows)
+}
+
+// yankHotspots copies the hotspots view as a Markdown table
+func (m Model) yankHotspots() tea.Cmd {
+	headers := []string{"File", "Commits", "Added", "Deleted", "Code", "Score"}
+	var rows [][]string
+	for _, h := range m.Hotspots {
+		rows = append(rows, []string{
+			"`" + m.relativePath(h.File.Path) + "`",
+			strconv.Itoa(h.Commits),
+			strconv.Itoa(h.Added),
+			strconv.Itoa(h.Deleted),
+			strconv.Itoa(h.File.Code),
+			strconv.Itoa(h.Score),
+		})
+	}
+	return CopyToClipboard(report.MarkdownTable(headers, rows), "table")
+}
@@ -0,0 +1,443 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/config"
+)
+
+// KeyMap contains every key binding used by the UI
+type KeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	Top          key.Binding
+	Bottom       key.Binding
+	Open         key.Binding
+	AllFiles     key.Binding
+	Back         key.Binding
+	Quit         key.Binding
+	ForceQuit    key.Binding
+	Filter       key.Binding
+	ApplyFilter  key.Binding
+	ClearFilter  key.Binding
+	Preview      key.Binding
+	FocusPreview key.Binding
+	Edit         key.Binding
+	Reload       key.Binding
+	Yank         key.Binding
+	YankView     key.Binding
+	Columns      key.Binding
+	Category     key.Binding
+	Groups       key.Binding
+	Expand       key.Binding
+	Buckets      key.Binding
+	Owners       key.Binding
+	Dirs         key.Binding
+	Hotspots     key.Binding
+	Authors      key.Binding
+	AuthorDirs   key.Binding
+	Changes      key.Binding
+	Tests        key.Binding
+	Submodules   key.Binding
+	ToggleColumn key.Binding
+	SortName     key.Binding
+	SortFiles    key.Binding
+	SortBlank    key.Binding
+	SortComment  key.Binding
+	SortCode     key.Binding
+	SortTotal    key.Binding
+	// Shift+digit adds a secondary sort key
+	ThenSortName    key.Binding
+	ThenSortFiles   key.Binding
+	ThenSortBlank   key.Binding
+	ThenSortComment key.Binding
+	ThenSortCode    key.Binding
+	ThenSortTotal   key.Binding
+	Help            key.Binding
+}
+
+// newBinding creates a binding whose help text shows all of its keys
+func newBinding(desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(helpKeys(keys), desc))
+}
+
+// helpKeys formats keys for display, e.g. "↑/k"
+func helpKeys(keys []string) string {
+	display := make([]string, len(keys))
+	for i, k := range keys {
+		switch k {
+		case "up":
+			display[i] = "↑"
+		case "down":
+			display[i] = "↓"
+		case " ":
+			display[i] = "space"
+		default:
+			display[i] = k
+		}
+	}
+	return strings.Join(display, "/")
+}
+
+// DefaultKeyMap returns the default bindings
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up:           newBinding("up", "up", "k"),
+		Down:         newBinding("down", "down", "j"),
+		Top:          newBinding("go to top", "home", "g"),
+		Bottom:       newBinding("go to bottom", "end", "G"),
+		Open:         newBinding("view files", "enter"),
+		AllFiles:     newBinding("all files", "a"),
+		Back:         newBinding("back / clear filter", "esc"),
+		Quit:         newBinding("quit", "q"),
+		ForceQuit:    newBinding("force quit", "ctrl+c"),
+		Filter:       newBinding("filter", "/"),
+		ApplyFilter:  newBinding("apply filter", "enter"),
+		ClearFilter:  newBinding("clear filter", "esc"),
+		Preview:      newBinding("toggle preview", "p"),
+		FocusPreview: newBinding("focus preview", "tab"),
+		Edit:         newBinding("open in editor", "e"),
+		Reload:       newBinding("rescan", "r"),
+		Yank:         newBinding("copy selected row", "y"),
+		YankView:     newBinding("copy view as Markdown", "Y"),
+		Columns:      newBinding("choose columns", "c"),
+		Category:     newBinding("cycle category", "t"),
+		Groups:       newBinding("toggle groups", "m"),
+		Expand:       newBinding("expand group", " "),
+		Buckets:      newBinding("path buckets", "b"),
+		Owners:       newBinding("code owners", "o"),
+		Dirs:         newBinding("directories", "f"),
+		Hotspots:     newBinding("churn hotspots", "H"),
+		Authors:      newBinding("authors", "A"),
+		AuthorDirs:   newBinding("by language / directory", "d"),
+		Changes:      newBinding("changes in diff", "D"),
+		Tests:        newBinding("hide / show tests", "T"),
+		Submodules:   newBinding("cycle submodules", "S"),
+		ToggleColumn: newBinding("show / hide column", " "),
+		SortName:     newBinding("sort by name", "1"),
+		SortFiles:    newBinding("sort by files", "2"),
+		SortBlank:    newBinding("sort by blank", "3"),
+		SortComment:  newBinding("sort by comment", "4"),
+		SortCode:     newBinding("sort by code", "5"),
+		SortTotal:    newBinding("sort by total", "6"),
+		// Shifted digits on a US layout
+		ThenSortName:    newBinding("then by name", "!"),
+		ThenSortFiles:   newBinding("then by files", "@"),
+		ThenSortBlank:   newBinding("then by blank", "#"),
+		ThenSortComment: newBinding("then by comment", "$"),
+		ThenSortCode:    newBinding("then by code", "%"),
+		ThenSortTotal:   newBinding("then by total", "^"),
+		Help:            newBinding("toggle help", "?"),
+	}
+}
+
+// VimKeyMap returns the default bindings with vim-style movement
+func VimKeyMap() KeyMap {
+	k := DefaultKeyMap()
+	k.Open = newBinding("view files", "enter", "l")
+	k.Back = newBinding("back / clear filter", "esc", "h")
+	k.Top = newBinding("go to top", "g", "home")
+	k.Bottom = newBinding("go to bottom", "G", "end")
+	k.FocusPreview = newBinding("focus preview", "tab", "ctrl+w")
+	return k
+}
+
+// EmacsKeyMap returns the default bindings with emacs-style movement
+func EmacsKeyMap() KeyMap {
+	k := DefaultKeyMap()
+	k.Up = newBinding("up", "up", "ctrl+p")
+	k.Down = newBinding("down", "down", "ctrl+n")
+	k.Top = newBinding("go to top", "home", "alt+<")
+	k.Bottom = newBinding("go to bottom", "end", "alt+>")
+	k.Back = newBinding("back / clear filter", "esc", "ctrl+g")
+	k.Filter = newBinding("filter", "/", "ctrl+s")
+	k.ClearFilter = newBinding("clear filter", "esc", "ctrl+g")
+	return k
+}
+
+// named returns the bindings by the names used in the config file
+func (k *KeyMap) named() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"up":                &k.Up,
+		"down":              &k.Down,
+		"top":               &k.Top,
+		"bottom":            &k.Bottom,
+		"open":              &k.Open,
+		"all_files":         &k.AllFiles,
+		"back":              &k.Back,
+		"quit":              &k.Quit,
+		"force_quit":        &k.ForceQuit,
+		"filter":            &k.Filter,
+		"apply_filter":      &k.ApplyFilter,
+		"clear_filter":      &k.ClearFilter,
+		"preview":           &k.Preview,
+		"focus_preview":     &k.FocusPreview,
+		"edit":              &k.Edit,
+		"reload":            &k.Reload,
+		"yank":              &k.Yank,
+		"yank_view":         &k.YankView,
+		"columns":           &k.Columns,
+		"category":          &k.Category,
+		"groups":            &k.Groups,
+		"expand":            &k.Expand,
+		"buckets":           &k.Buckets,
+		"owners":            &k.Owners,
+		"dirs":              &k.Dirs,
+		"hotspots":          &k.Hotspots,
+		"authors":           &k.Authors,
+		"author_dirs":       &k.AuthorDirs,
+		"changes":           &k.Changes,
+		"tests":             &k.Tests,
+		"submodules":        &k.Submodules,
+		"toggle_column":     &k.ToggleColumn,
+		"sort_name":         &k.SortName,
+		"sort_files":        &k.SortFiles,
+		"sort_blank":        &k.SortBlank,
+		"sort_comment":      &k.SortComment,
+		"sort_code":         &k.SortCode,
+		"sort_total":        &k.SortTotal,
+		"then_sort_name":    &k.ThenSortName,
+		"then_sort_files":   &k.ThenSortFiles,
+		"then_sort_blank":   &k.ThenSortBlank,
+		"then_sort_comment": &k.ThenSortComment,
+		"then_sort_code":    &k.ThenSortCode,
+		"then_sort_total":   &k.ThenSortTotal,
+		"help":              &k.Help,
+	}
+}
+
+// NewKeyMap builds the keymap from a preset and the user's overrides
+func NewKeyMap(cfg config.KeysConfig) (KeyMap, error) {
+	var k KeyMap
+	switch cfg.Preset {
+	case "", "default":
+		k = DefaultKeyMap()
+	case "vim":
+		k = VimKeyMap()
+	case "emacs":
+		k = EmacsKeyMap()
+	default:
+		return k, fmt.Errorf("unknown key preset %q (want default, vim or emacs)", cfg.Preset)
+	}
+
+	named := k.named()
+	for name, keys := range cfg.Bindings {
+		binding, ok := named[name]
+		if !ok {
+			return k, fmt.Errorf("unknown key binding %q (want one of %s)", name, strings.Join(bindingNames(named), ", "))
+		}
+		if len(keys) == 0 {
+			binding.SetEnabled(false)
+			continue
+		}
+		binding.SetKeys(keys...)
+		binding.SetHelp(helpKeys(keys), binding.Help().Desc)
+	}
+	return k, nil
+}
+
+func bindingNames(named map[string]*key.Binding) []string {
+	names := make([]string, 0, len(named))
+	for name := range named {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// viewKey is a binding as it applies in one view, with the description it
+// has there and, if the help line shows it, its label there
+type viewKey struct {
+	Binding key.Binding
+	Short   string
+}
+
+// helpSection is a titled group of bindings in the help overlay
+type helpSection struct {
+	Title string
+	Keys  []viewKey
+}
+
+// Key contexts after the views, for the overlays that take keys in their place
+const (
+	filterKeys ViewMode = DiffView + 1 + iota
+	columnKeys
+	previewKeys
+)
+
+// withDesc returns a copy of the binding with a different help description
+func withDesc(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// keys wraps bindings that the help line leaves out
+func keys(bindings ...key.Binding) []viewKey {
+	view := make([]viewKey, len(bindings))
+	for i, b := range bindings {
+		view[i] = viewKey{Binding: b}
+	}
+	return view
+}
+
+// keyTable returns the bindings that apply in each view and overlay. The help
+// overlay and help line are built from it, and the views ignore any other key
+func keyTable(k KeyMap, vp viewport.KeyMap) map[ViewMode][]helpSection {
+	navigation := helpSection{"Navigation", []viewKey{{k.Up, "navigate"}, {k.Down, "navigate"}, {Binding: k.Top}, {Binding: k.Bottom}}}
+	views := helpSection{"Views", keys(k.AllFiles, k.Buckets, k.Owners, k.Dirs, k.Hotspots, k.Authors, k.Changes)}
+	report := helpSection{"General", []viewKey{{withDesc(k.Back, "back"), "back"}, {withDesc(k.Quit, "back"), "back"}, {Binding: k.ForceQuit}, {k.Help, "help"}}}
+	fileList := helpSection{"General", []viewKey{{k.Back, "back"}, {withDesc(k.Quit, "back"), "back"}, {Binding: k.ForceQuit}, {k.Help, "help"}}}
+
+	// sortSections returns the sort and secondary sort keys, with the name
+	// and files columns called what the view calls them, and no files column
+	// where files is empty
+	sortSections := func(name, files string) (helpSection, helpSection) {
+		primary := []viewKey{{withDesc(k.SortName, "sort by "+name), "sort"}, {withDesc(k.SortFiles, "sort by "+files), "sort"}, {k.SortBlank, "sort"}, {k.SortComment, "sort"}, {k.SortCode, "sort"}, {k.SortTotal, "sort"}}
+		then := keys(withDesc(k.ThenSortName, "then by "+name), withDesc(k.ThenSortFiles, "then by "+files), k.ThenSortBlank, k.ThenSortComment, k.ThenSortCode, k.ThenSortTotal)
+		if files == "" {
+			primary = slices.Delete(primary, 1, 2)
+			then = slices.Delete(then, 1, 2)
+		}
+		return helpSection{"Sorting", primary}, helpSection{"Secondary sort", then}
+	}
+	langSort, langThen := sortSections("name", "files")
+	fileSort, fileThen := sortSections("name", "")
+	allSort, allThen := sortSections("path", "language")
+
+	return map[ViewMode][]helpSection{
+		LanguageView: {
+			navigation,
+			langSort,
+			langThen,
+			{"Actions", []viewKey{{k.Open, "view files"}, {Binding: k.Expand}, {k.Filter, "filter"}, {Binding: k.Category}, {Binding: k.Groups}, {Binding: k.Tests}, {Binding: k.Submodules}, {Binding: k.Columns}, {Binding: k.Yank}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			{"General", []viewKey{{Binding: withDesc(k.Back, "clear filter")}, {k.Help, "help"}, {k.Quit, "quit"}, {Binding: k.ForceQuit}}},
+		},
+		FileView: {
+			navigation,
+			fileSort,
+			fileThen,
+			{"Actions", []viewKey{{k.Filter, "filter"}, {k.Preview, "preview"}, {Binding: k.FocusPreview}, {k.Edit, "edit"}, {Binding: k.Tests}, {Binding: withDesc(k.Yank, "copy path")}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			fileList,
+		},
+		AllFilesView: {
+			navigation,
+			allSort,
+			allThen,
+			{"Actions", []viewKey{{withDesc(k.Open, "go to language"), "go to language"}, {k.Filter, "filter"}, {k.Preview, "preview"}, {Binding: k.FocusPreview}, {Binding: k.Edit}, {Binding: k.Tests}, {Binding: withDesc(k.Yank, "copy path")}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			fileList,
+		},
+		BucketView: {
+			navigation,
+			{"Actions", []viewKey{{withDesc(k.Open, "view files"), "view files"}, {withDesc(k.Expand, "show languages"), "languages"}, {Binding: k.Tests}, {withDesc(k.Yank, "copy row"), "copy"}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			report,
+		},
+		HotspotView: {
+			navigation,
+			{"Actions", []viewKey{{withDesc(k.Open, "go to file"), "go to file"}, {Binding: k.Tests}, {withDesc(k.Yank, "copy path"), "copy"}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			report,
+		},
+		AuthorView: {
+			navigation,
+			{"Actions", []viewKey{{withDesc(k.Expand, "show breakdown"), "breakdown"}, {Binding: withDesc(k.Open, "show breakdown")}, {k.AuthorDirs, "languages / directories"}, {Binding: k.Tests}, {Binding: withDesc(k.Yank, "copy row")}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			report,
+		},
+		DiffView: {
+			navigation,
+			{"Actions", []viewKey{{withDesc(k.Open, "show files / go to file"), "files"}, {Binding: withDesc(k.Expand, "show files")}, {Binding: k.Tests}, {withDesc(k.Yank, "copy row"), "copy"}, {Binding: k.YankView}, {Binding: k.Reload}}},
+			views,
+			report,
+		},
+		filterKeys: {
+			{"Filter", []viewKey{{k.Up, "navigate"}, {k.Down, "navigate"}, {k.ApplyFilter, "apply filter"}, {k.ClearFilter, "clear filter"}, {Binding: k.ForceQuit}}},
+		},
+		columnKeys: {
+			{"Columns", []viewKey{{k.Up, "navigate"}, {k.Down, "navigate"}, {k.ToggleColumn, "show / hide"}, {withDesc(k.Open, "sort by column"), "sort"}, {withDesc(k.Columns, "close"), "close"}, {Binding: k.ForceQuit}}},
+		},
+		previewKeys: {
+			{"Scrolling", []viewKey{{vp.Up, "scroll"}, {vp.Down, "scroll"}, {vp.PageUp, "page"}, {vp.PageDown, "page"}, {Binding: vp.HalfPageUp}, {Binding: vp.HalfPageDown}, {Binding: k.Top}, {Binding: k.Bottom}}},
+			{"General", []viewKey{{withDesc(k.FocusPreview, "back to files"), "back to files"}, {Binding: k.ForceQuit}, {k.Help, "help"}}},
+		},
+	}
+}
+
+// keyContext returns the view or overlay that takes the keys
+func (m Model) keyContext() ViewMode {
+	switch {
+	case m.Filtering:
+		return filterKeys
+	case m.ShowColumns:
+		return columnKeys
+	case m.isFileList() && m.PreviewFocused:
+		return previewKeys
+	}
+	return m.Mode
+}
+
+// helpSections returns the bindings available in the current view
+func (m Model) helpSections() []helpSection {
+	return keyTable(m.Keys, m.Preview.KeyMap)[m.keyContext()]
+}
+
+// keyApplies reports whether a key is bound in the current view
+func (m Model) keyApplies(msg tea.KeyMsg) bool {
+	for _, section := range m.helpSections() {
+		for _, k := range section.Keys {
+			if key.Matches(msg, k.Binding) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// firstKey returns the first key of a binding for compact help
+func firstKey(b key.Binding) string {
+	if keys := b.Keys(); len(keys) > 0 {
+		return helpKeys(keys[:1])
+	}
+	return ""
+}
+
+// shortHelp returns the key/description pairs shown in the help line. Keys
+// sharing a label share an entry, e.g. "↑/↓ navigate" or "1-6 sort"
+func (m Model) shortHelp() [][2]string {
+	var labels []string
+	grouped := map[string][]string{}
+	for _, section := range m.helpSections() {
+		for _, k := range section.Keys {
+			if k.Short == "" || !k.Binding.Enabled() {
+				continue
+			}
+			if _, ok := grouped[k.Short]; !ok {
+				labels = append(labels, k.Short)
+			}
+			grouped[k.Short] = append(grouped[k.Short], firstKey(k.Binding))
+		}
+	}
+
+	entries := make([][2]string, len(labels))
+	for i, label := range labels {
+		keys := grouped[label]
+		switch len(keys) {
+		case 1:
+			entries[i] = [2]string{keys[0], label}
+		case 2:
+			entries[i] = [2]string{keys[0] + "/" + keys[1], label}
+		default:
+			entries[i] = [2]string{keys[0] + "-" + keys[len(keys)-1], label}
+		}
+	}
+	return entries
+}
@@ -1,51 +1,178 @@
 package ui
 
 import (
+	"cmp"
+	"runtime"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/devin/gloc/cloc"
+	"github.com/devin/gloc/colors"
+	"github.com/devin/gloc/config"
 )
 
 // Model is the main application model
 type Model struct {
-	Result           *cloc.Result
-	Mode             ViewMode
-	SelectedLang     string
-	Cursor           int
-	FileCursor       int
-	Width            int
-	Height           int
-	TargetPath       string
-	IsGit            bool
-	Err              error
-	SortCol          SortColumn
-	SortAsc          bool
-	FileSortCol      SortColumn
-	FileSortAsc      bool
-	ScrollOffset     int
-	FileScrollOffset int
+	FullResult *cloc.Result // Every language from the last scan
+	Result     *cloc.Result // The languages in the current category
+	Category   string       // Linguist type shown, or empty for all
+	Groups     map[string][]string
+	ShowGroups bool            // Merge grouped languages
+	Expanded   map[string]bool // Groups listing their members
+	Tests      cloc.TestMatcher
+	HideTests  bool // Leave test files out
+	// Include, exclude or separate submodules
+	SubmoduleMode string
+	// Path and owner buckets
+	BucketConfig       []config.Bucket
+	BucketKind         BucketKind
+	Buckets            []cloc.Bucket
+	ExpandedBuckets    map[string]bool // Buckets listing their languages
+	BucketCursor       int
+	BucketScrollOffset int
+	SelectedBucket     string // Bucket listed in the all-files view, if any
+	SelectedBucketLang string // Language of the bucket listed, if any
+	// Churn hotspots
+	HotspotSince        string                // Git date churn is counted from, or empty for all history
+	Churn               map[string]cloc.Churn // Nil until the history is read
+	LoadingChurn        bool
+	Hotspots            []cloc.Hotspot
+	HotspotCursor       int
+	HotspotScrollOffset int
+	// Authors
+	AuthorMode         string
+	AuthorWorkers      int
+	Authorship         cloc.Authorship // Nil until attributed
+	LoadingAuthors     bool
+	Authors            []cloc.Author
+	ExpandedAuthors    map[string]bool // Authors listing their languages or directories
+	AuthorsByDir       bool            // Break authors down by directory rather than language
+	AuthorCursor       int
+	AuthorScrollOffset int
+	FileTimes          map[string]time.Time // Last change of each file, nil until read
+	// Changes in a diff
+	Diff               cloc.Diff
+	Changes            *cloc.Changes // Nil until the diff is counted
+	LoadingChanges     bool
+	ExpandedChanges    map[string]bool // Languages listing their files
+	ChangeCursor       int
+	ChangeScrollOffset int
+	Mode               ViewMode
+	SelectedLang       string
+	Cursor             int
+	FileCursor         int
+	Width              int
+	Height             int
+	TargetPath         string
+	IsGit              bool
+	Err                error
+	Rescanning         bool
+	StatusMsg          string
+	Keys               KeyMap
+	ShowHelp           bool
+	LangSort           []SortKey // Sort stack, primary key first
+	FileSort           []SortKey
+	ScrollOffset       int
+	FileScrollOffset   int
+	// Filtering
+	FilterInput textinput.Model
+	Filtering   bool
+	LangFilter  string
+	FileFilter  string
+	// Source preview
+	ShowPreview    bool
+	PreviewFocused bool
+	PreviewPath    string
+	Preview        viewport.Model
+	// Language table columns
+	LangColumns  []string
+	Metrics      map[string]cloc.LanguageMetrics
+	ShowColumns  bool
+	ColumnCursor int
+	// Mouse double-click tracking
+	lastClickRow  int
+	lastClickTime time.Time
 	// Dynamic column widths
-	ColLanguage int
-	ColFiles    int
-	ColBlank    int
-	ColComment  int
-	ColCode     int
-	ColFilePath int
+	ColLanguage   int
+	LangColWidths []int
+	ColFilePath   int
 }
 
-// NewModel creates a new model with the given path
-func NewModel(path string, isGit bool) Model {
-	return Model{
-		TargetPath:  path,
-		IsGit:       isGit,
-		Mode:        LanguageView,
-		SortCol:     SortByCode,
-		SortAsc:     false, // descending by default
-		FileSortCol: SortByCode,
-		FileSortAsc: false,
+// NewModel creates a new model with the given path and user config
+func NewModel(path string, isGit bool, cfg *config.Config) (Model, error) {
+	keys, err := NewKeyMap(cfg.Keys)
+	if err != nil {
+		return Model{}, err
+	}
+
+	if err := ConfigureColor(cfg.Color); err != nil {
+		return Model{}, err
+	}
+	theme, err := ResolveTheme(cfg)
+	if err != nil {
+		return Model{}, err
+	}
+	ApplyTheme(theme)
+	colors.SetBackground(TerminalBackground(theme))
+
+	columns, err := parseColumns(cfg.Columns)
+	if err != nil {
+		return Model{}, err
+	}
+	category, err := parseCategory(cfg.Category)
+	if err != nil {
+		return Model{}, err
+	}
+	buckets, err := parseBuckets(cfg.Buckets)
+	if err != nil {
+		return Model{}, err
+	}
+	tests, err := parseTestPatterns(cfg.Tests.Patterns)
+	if err != nil {
+		return Model{}, err
+	}
+	authorMode, err := parseAuthorMode(cfg.Authors.Mode)
+	if err != nil {
+		return Model{}, err
+	}
+	submoduleMode, err := parseSubmoduleMode(cfg.Submodules)
+	if err != nil {
+		return Model{}, err
 	}
+	groups, err := parseGroups(cfg.Groups)
+	if err != nil {
+		return Model{}, err
+	}
+
+	return Model{
+		TargetPath:      path,
+		IsGit:           isGit,
+		Mode:            LanguageView,
+		LangSort:        []SortKey{{Col: SortByCode}}, // descending by default
+		FileSort:        []SortKey{{Col: SortByCode}},
+		FilterInput:     newFilterInput(),
+		Preview:         viewport.New(0, 0),
+		Keys:            keys,
+		LangColumns:     columns,
+		Category:        category,
+		Groups:          groups,
+		ShowGroups:      len(groups) > 0,
+		Expanded:        make(map[string]bool),
+		Tests:           tests,
+		HotspotSince:    parseSince(cfg.Hotspots.Since),
+		AuthorMode:      authorMode,
+		AuthorWorkers:   cmp.Or(cfg.Authors.Workers, runtime.NumCPU()),
+		ExpandedAuthors: make(map[string]bool),
+		ExpandedChanges: make(map[string]bool),
+		HideTests:       cfg.Tests.Hide,
+		SubmoduleMode:   submoduleMode,
+		BucketConfig:    buckets,
+		ExpandedBuckets: make(map[string]bool),
+	}, nil
 }
 
 // ClocResultMsg is the message returned when cloc finishes
@@ -77,24 +204,40 @@ func (m *Model) ContentWidth() int {
 func (m *Model) CalculateColumnWidths() {
 	availableWidth := m.ContentWidth()
 
-	// Language view: distribute space proportionally
-	fixedCols := MinColFiles + MinColBlank + MinColComment + MinColCode
-	extraSpace := availableWidth - fixedCols - MinColLanguage - 3 // 3 for dot and spacing
+	// Language view: each numeric column needs room for its padded header and sort arrow
+	columns := m.activeColumns()
+	m.LangColWidths = make([]int, len(columns))
+	fixedCols := 0
+	for i, col := range columns {
+		m.LangColWidths[i] = columnWidth(col)
+		fixedCols += m.LangColWidths[i]
+	}
+	// A separator between each pair of columns plus the table's side borders
+	extraSpace := availableWidth - fixedCols - MinColLanguage - len(columns) - 2
+	if MinColLanguage+extraSpace < minColLanguageNarrow {
+		// Too narrow for full headers, which fitHeader then shortens
+		fixedCols = 0
+		for i, col := range columns {
+			m.LangColWidths[i] = col.MinWidth
+			fixedCols += col.MinWidth
+		}
+		extraSpace = availableWidth - fixedCols - MinColLanguage - len(columns) - 2
+	}
 
+	m.ColLanguage = MinColLanguage
 	if extraSpace > 0 {
-		// Give extra space mostly to language name, some to numeric columns
-		m.ColLanguage = MinColLanguage + (extraSpace * 50 / 100)
-		remaining := extraSpace - (extraSpace * 50 / 100)
-		m.ColFiles = MinColFiles + (remaining * 20 / 100)
-		m.ColBlank = MinColBlank + (remaining * 20 / 100)
-		m.ColComment = MinColComment + (remaining * 30 / 100)
-		m.ColCode = MinColCode + (remaining * 30 / 100)
+		// Give extra space mostly to language name, the rest spread over numeric columns
+		share := extraSpace / 2
+		if len(columns) > 0 {
+			share /= len(columns)
+		}
+		for i := range m.LangColWidths {
+			m.LangColWidths[i] += share
+		}
+		m.ColLanguage += extraSpace - share*len(columns)
 	} else {
-		m.ColLanguage = MinColLanguage
-		m.ColFiles = MinColFiles
-		m.ColBlank = MinColBlank
-		m.ColComment = MinColComment
-		m.ColCode = MinColCode
+		// Narrow terminals take the shortfall from the language name
+		m.ColLanguage = max(MinColLanguage+extraSpace, minColLanguageNarrow)
 	}
 
 	// File view: give most space to file path
@@ -103,73 +246,141 @@ func (m *Model) CalculateColumnWidths() {
 	if m.ColFilePath < 40 {
 		m.ColFilePath = 40
 	}
+
+	m.resizePreview()
 }
 
-// SortLanguages sorts the languages based on current sort settings
+// SortLanguages sorts the languages, and the members of each group, by every
+// key in the sort stack. The sort is stable with the name as a final
+// tiebreaker, so equal rows keep their order.
 func (m *Model) SortLanguages() {
 	if m.Result == nil {
 		return
 	}
 
-	sort.Slice(m.Result.Languages, func(i, j int) bool {
-		var less bool
-		switch m.SortCol {
-		case SortByName:
-			less = strings.ToLower(m.Result.Languages[i].Name) < strings.ToLower(m.Result.Languages[j].Name)
-		case SortByFiles:
-			less = m.Result.Languages[i].Files < m.Result.Languages[j].Files
-		case SortByBlank:
-			less = m.Result.Languages[i].Blank < m.Result.Languages[j].Blank
-		case SortByComment:
-			less = m.Result.Languages[i].Comment < m.Result.Languages[j].Comment
-		case SortByCode:
-			less = m.Result.Languages[i].Code < m.Result.Languages[j].Code
-		case SortByTotal:
-			totalI := m.Result.Languages[i].Code + m.Result.Languages[i].Comment + m.Result.Languages[i].Blank
-			totalJ := m.Result.Languages[j].Code + m.Result.Languages[j].Comment + m.Result.Languages[j].Blank
-			less = totalI < totalJ
-		}
-		if m.SortAsc {
-			return less
+	m.sortLanguageList(m.Result.Languages)
+	for _, members := range m.Result.Members {
+		m.sortLanguageList(members)
+	}
+}
+
+// sortLanguageList sorts languages in place by the language sort stack
+func (m *Model) sortLanguageList(langs []cloc.LanguageStats) {
+	sort.SliceStable(langs, func(i, j int) bool {
+		a, b := langs[i], langs[j]
+		for _, key := range m.LangSort {
+			if c := m.compareLanguages(a, b, key.Col); c != 0 {
+				if key.Asc {
+					return c < 0
+				}
+				return c > 0
+			}
 		}
-		return !less
+		return m.compareLanguages(a, b, SortByName) < 0
 	})
 }
 
+// compareLanguages compares two languages by a single column
+func (m *Model) compareLanguages(a, b cloc.LanguageStats, col SortColumn) int {
+	switch col {
+	case SortByName:
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case SortByFiles:
+		return cmp.Compare(a.Files, b.Files)
+	case SortByBlank:
+		return cmp.Compare(a.Blank, b.Blank)
+	case SortByComment:
+		return cmp.Compare(a.Comment, b.Comment)
+	case SortByCode:
+		return cmp.Compare(a.Code, b.Code)
+	case SortByTotal:
+		return cmp.Compare(a.Code+a.Comment+a.Blank, b.Code+b.Comment+b.Blank)
+	default:
+		return cmp.Compare(m.languageValue(a, col), m.languageValue(b, col))
+	}
+}
+
 // SortFiles returns sorted files for the given language
 func (m *Model) SortFiles(lang string) []cloc.FileInfo {
 	files := make([]cloc.FileInfo, len(m.Result.Files[lang]))
 	copy(files, m.Result.Files[lang])
+	m.sortFileList(files)
+	return files
+}
 
-	sort.Slice(files, func(i, j int) bool {
-		var less bool
-		switch m.FileSortCol {
-		case SortByName:
-			less = strings.ToLower(files[i].Path) < strings.ToLower(files[j].Path)
-		case SortByBlank:
-			less = files[i].Blank < files[j].Blank
-		case SortByComment:
-			less = files[i].Comment < files[j].Comment
-		case SortByCode:
-			less = files[i].Code < files[j].Code
-		case SortByTotal:
-			totalI := files[i].Code + files[i].Comment + files[i].Blank
-			totalJ := files[j].Code + files[j].Comment + files[j].Blank
-			less = totalI < totalJ
-		default:
-			less = files[i].Code < files[j].Code
+// SortAllFiles returns the files of every language, or of the selected
+// bucket, in one sorted list
+func (m *Model) SortAllFiles() []cloc.FileInfo {
+	var files []cloc.FileInfo
+	if bucket, ok := m.selectedBucket(); ok {
+		for _, file := range bucket.Files {
+			if m.SelectedBucketLang == "" || file.Language == m.SelectedBucketLang {
+				files = append(files, file)
+			}
 		}
-		if m.FileSortAsc {
-			return less
+	} else {
+		for _, lang := range m.Result.Languages {
+			files = append(files, m.Result.Files[lang.Name]...)
 		}
-		return !less
-	})
+	}
+	m.sortFileList(files)
 	return files
 }
 
+// isFileList reports whether the current view lists files rather than languages
+func (m Model) isFileList() bool {
+	return m.Mode == FileView || m.Mode == AllFilesView
+}
+
+// isReport reports whether the current view is one of the bucket, hotspot,
+// author or changes reports, which have their own cursor and no filter or
+// sort keys
+func (m Model) isReport() bool {
+	return m.Mode == BucketView || m.Mode == HotspotView || m.Mode == AuthorView || m.Mode == DiffView
+}
+
+// sortFileList sorts files by every key in the file sort stack, stably and
+// with the path as a final tiebreaker
+func (m *Model) sortFileList(files []cloc.FileInfo) {
+	sort.SliceStable(files, func(i, j int) bool {
+		for _, key := range m.FileSort {
+			if c := compareFiles(files[i], files[j], key.Col); c != 0 {
+				if key.Asc {
+					return c < 0
+				}
+				return c > 0
+			}
+		}
+		return compareFiles(files[i], files[j], SortByName) < 0
+	})
+}
+
+// compareFiles compares two files by a single column
+func compareFiles(a, b cloc.FileInfo, col SortColumn) int {
+	switch col {
+	case SortByName:
+		return strings.Compare(strings.ToLower(a.Path), strings.ToLower(b.Path))
+	case SortByLanguage:
+		return strings.Compare(strings.ToLower(a.Language), strings.ToLower(b.Language))
+	case SortByBlank:
+		return cmp.Compare(a.Blank, b.Blank)
+	case SortByComment:
+		return cmp.Compare(a.Comment, b.Comment)
+	case SortByTotal:
+		return cmp.Compare(a.Code+a.Comment+a.Blank, b.Code+b.Comment+b.Blank)
+	case SortByAge:
+		return a.Modified.Compare(b.Modified)
+	default:
+		return cmp.Compare(a.Code, b.Code)
+	}
+}
+
 // VisibleRows returns the number of visible rows based on terminal height
 func (m *Model) VisibleRows() int {
 	rows := m.Height - 12
+	if m.Mode == LanguageView {
+		rows -= m.summaryHeight()
+	}
 	if rows < 1 {
 		return 10
 	}
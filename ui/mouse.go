@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Mouse behaviour
+const (
+	doubleClickTime = 400 * time.Millisecond
+	wheelStep       = 3
+)
+
+func (m Model) handleMouseMsg(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.Result == nil || m.Filtering {
+		return m, nil
+	}
+
+	// Events over the preview pane scroll the preview
+	x := msg.X - AppStyle.GetPaddingLeft()
+	if m.isFileList() && m.ShowPreview && x >= m.fileTableWidth() {
+		var cmd tea.Cmd
+		m.Preview, cmd = m.Preview.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.scrollBy(-wheelStep)
+	case tea.MouseButtonWheelDown:
+		m.scrollBy(wheelStep)
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionPress {
+			return m, nil
+		}
+		headerLine, firstRowLine := m.tableLines()
+		if msg.Y == headerLine {
+			m.sortByHeaderAt(x, msg.Shift)
+			return m, nil
+		}
+		if msg.Y >= firstRowLine {
+			m.clickRow(msg.Y - firstRowLine)
+		}
+	}
+
+	return m, m.syncPreview()
+}
+
+// tableLines returns the screen lines of the table's header and first row,
+// below AppStyle's padding, the view's head as View wraps it, and the
+// table's hidden border
+func (m Model) tableLines() (header, firstRow int) {
+	head := lipgloss.NewStyle().Width(m.ContentWidth()).Render(m.viewHead())
+	// The head ends in a newline, so the table starts on its last line
+	header = AppStyle.GetPaddingTop() + lipgloss.Height(head)
+	// Below the header is the line under it
+	return header, header + 2
+}
+
+// scrollBy moves the scroll offset by delta rows, keeping the cursor on screen
+func (m *Model) scrollBy(delta int) {
+	visibleRows := m.VisibleRows()
+	if m.Mode == BucketView {
+		maxOffset := max(len(m.bucketRows())-visibleRows, 0)
+		m.BucketScrollOffset = min(max(m.BucketScrollOffset+delta, 0), maxOffset)
+		m.BucketCursor = min(max(m.BucketCursor, m.BucketScrollOffset), m.BucketScrollOffset+visibleRows-1)
+	} else if m.Mode == AuthorView {
+		maxOffset := max(len(m.authorRows())-visibleRows, 0)
+		m.AuthorScrollOffset = min(max(m.AuthorScrollOffset+delta, 0), maxOffset)
+		m.AuthorCursor = min(max(m.AuthorCursor, m.AuthorScrollOffset), m.AuthorScrollOffset+visibleRows-1)
+	} else if m.Mode == DiffView {
+		maxOffset := max(len(m.changeRows())-visibleRows, 0)
+		m.ChangeScrollOffset = min(max(m.ChangeScrollOffset+delta, 0), maxOffset)
+		m.ChangeCursor = min(max(m.ChangeCursor, m.ChangeScrollOffset), m.ChangeScrollOffset+visibleRows-1)
+	} else if m.Mode == HotspotView {
+		maxOffset := max(len(m.Hotspots)-visibleRows, 0)
+		m.HotspotScrollOffset = min(max(m.HotspotScrollOffset+delta, 0), maxOffset)
+		m.HotspotCursor = min(max(m.HotspotCursor, m.HotspotScrollOffset), m.HotspotScrollOffset+visibleRows-1)
+	} else if m.Mode == LanguageView {
+		maxOffset := max(len(m.VisibleLanguages())-visibleRows, 0)
+		m.ScrollOffset = min(max(m.ScrollOffset+delta, 0), maxOffset)
+		m.Cursor = min(max(m.Cursor, m.ScrollOffset), m.ScrollOffset+visibleRows-1)
+	} else {
+		maxOffset := max(len(m.VisibleFiles())-visibleRows, 0)
+		m.FileScrollOffset = min(max(m.FileScrollOffset+delta, 0), maxOffset)
+		m.FileCursor = min(max(m.FileCursor, m.FileScrollOffset), m.FileScrollOffset+visibleRows-1)
+	}
+	m.clampCursors()
+}
+
+// clickRow moves the cursor to the clicked row; double-clicking a language opens it
+func (m *Model) clickRow(row int) {
+	if row >= m.VisibleRows() {
+		return
+	}
+
+	if m.Mode == BucketView {
+		m.clickBucket(row)
+		return
+	}
+	if m.Mode == HotspotView {
+		m.clickHotspot(row)
+		return
+	}
+	if m.Mode == AuthorView {
+		m.clickAuthor(row)
+		return
+	}
+	if m.Mode == DiffView {
+		m.clickChange(row)
+		return
+	}
+	if m.Mode == LanguageView {
+		idx := m.ScrollOffset + row
+		if idx >= len(m.VisibleLanguages()) {
+			return
+		}
+		doubleClick := idx == m.Cursor && idx == m.lastClickRow && time.Since(m.lastClickTime) < doubleClickTime
+		m.Cursor = idx
+		m.lastClickRow = idx
+		m.lastClickTime = time.Now()
+		if doubleClick {
+			m.openSelectedLanguage()
+			m.lastClickRow = -1
+		}
+		return
+	}
+
+	idx := m.FileScrollOffset + row
+	if idx < len(m.VisibleFiles()) {
+		m.FileCursor = idx
+	}
+}
+
+// sortByHeaderAt sorts by the header column under screen column x, like the
+// sort keys; with add set the column becomes a further sort key
+func (m *Model) sortByHeaderAt(x int, add bool) {
+	if m.isReport() {
+		return
+	}
+	headers := m.languageHeaders()
+	columns := m.languageColumns()
+	t, _ := m.languageTable()
+	if m.isFileList() {
+		headers = m.fileHeaders()
+		columns = m.fileColumns()
+		t, _ = m.fileTable()
+	}
+
+	lines := strings.Split(ansi.Strip(t.Render()), "\n")
+	if len(lines) < 2 {
+		return
+	}
+	if col := headerColumnAt(lines[1], headers, x); col >= 0 {
+		m.handleSort(columns[col], add)
+	}
+}
+
+// headerColumnAt returns the index of the header label nearest to column x
+// in a rendered header line, or -1 if no label could be found
+func headerColumnAt(line string, headers []string, x int) int {
+	best, bestDist := -1, 0
+	for i, header := range headers {
+		start := strings.Index(line, header)
+		if start < 0 {
+			continue
+		}
+		startCell := lipgloss.Width(line[:start])
+		endCell := startCell + lipgloss.Width(header)
+
+		dist := 0
+		if x < startCell {
+			dist = startCell - x
+		} else if x >= endCell {
+			dist = x - endCell + 1
+		}
+		if best < 0 || dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+	return best
+}
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/cloc"
+)
+
+// PreviewMsg is the message returned when a file's source has been loaded
+type PreviewMsg struct {
+	Path     string
+	Language string
+	Content  []byte
+	Err      error
+}
+
+// LoadPreview reads a file's source and returns a command
+func LoadPreview(file cloc.FileInfo, ref string, isGit bool) tea.Cmd {
+	return func() tea.Msg {
+		content, err := cloc.ReadSource(file.Path, ref, isGit)
+		return PreviewMsg{Path: file.Path, Language: file.Language, Content: content, Err: err}
+	}
+}
+
+// SelectedFile returns the file under the cursor in the file view
+func (m Model) SelectedFile() (cloc.FileInfo, bool) {
+	files := m.VisibleFiles()
+	if m.FileCursor < 0 || m.FileCursor >= len(files) {
+		return cloc.FileInfo{}, false
+	}
+	return files[m.FileCursor], true
+}
+
+// syncPreview loads the selected file into the preview pane if it changed
+func (m *Model) syncPreview() tea.Cmd {
+	if !m.ShowPreview || !m.isFileList() {
+		return nil
+	}
+	file, ok := m.SelectedFile()
+	if !ok {
+		m.PreviewPath = ""
+		m.Preview.SetContent("")
+		return nil
+	}
+	if file.Path == m.PreviewPath {
+		return nil
+	}
+	m.PreviewPath = file.Path
+	return LoadPreview(file, m.TargetPath, m.IsGit)
+}
+
+// handlePreviewMsg fills the preview pane with the loaded source
+func (m *Model) handlePreviewMsg(msg PreviewMsg) {
+	// Ignore results for files the cursor has already moved past
+	if msg.Path != m.PreviewPath {
+		return
+	}
+	if msg.Err != nil {
+		m.Preview.SetContent(fmt.Sprintf("Unable to read file: %v", msg.Err))
+	} else {
+		m.Preview.SetContent(renderSource(msg.Language, string(msg.Content)))
+	}
+	m.Preview.GotoTop()
+}
+
+// handlePreviewKey scrolls the preview pane while it has focus
+func (m Model) handlePreviewKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	k := m.Keys
+	switch {
+	case key.Matches(msg, k.ForceQuit):
+		return m, tea.Quit
+	case key.Matches(msg, k.Help):
+		m.ShowHelp = true
+		return m, nil
+	case key.Matches(msg, k.FocusPreview, k.Back, k.Quit):
+		m.PreviewFocused = false
+		return m, nil
+	case key.Matches(msg, k.Top):
+		m.Preview.GotoTop()
+		return m, nil
+	case key.Matches(msg, k.Bottom):
+		m.Preview.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Preview, cmd = m.Preview.Update(msg)
+	return m, cmd
+}
+
+// resizePreview fits the preview pane next to the file table
+func (m *Model) resizePreview() {
+	m.Preview.Width = m.ContentWidth() - m.fileTableWidth() - PreviewStyle.GetHorizontalFrameSize()
+	m.Preview.Height = m.VisibleRows() + 2
+}
+
+// fileTableWidth returns the width of the file table, leaving room for the preview
+func (m Model) fileTableWidth() int {
+	if !m.ShowPreview {
+		return m.ContentWidth()
+	}
+	return m.ContentWidth() * 55 / 100
+}
+
+// renderSource renders file content with line numbers, dimming comment and blank lines
+func renderSource(language, content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.TrimSuffix(content, "\n")
+	lines := strings.Split(content, "\n")
+	kinds := cloc.ClassifyLines(language, lines)
+	gutterWidth := len(fmt.Sprint(len(lines)))
+
+	var b strings.Builder
+	for i, line := range lines {
+		line = strings.ReplaceAll(line, "\t", "    ")
+		number := fmt.Sprintf("%*d │ ", gutterWidth, i+1)
+
+		switch kinds[i] {
+		case cloc.LineBlank:
+			b.WriteString(BlankStyle.Render(number))
+		case cloc.LineComment:
+			b.WriteString(DividerStyle.Render(number))
+			b.WriteString(CommentStyle.Render(line))
+		default:
+			b.WriteString(DividerStyle.Render(number))
+			b.WriteString(NormalRowStyle.Render(line))
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// renderPreview renders the preview pane with a header for the selected file
+func (m Model) renderPreview() string {
+	header := TitleStyle.Render(m.relativePath(m.PreviewPath))
+	if m.PreviewFocused {
+		header = CursorStyle.Render("▶ ") + header
+	}
+	scroll := HelpStyle.Render(fmt.Sprintf(" %3.f%%", m.Preview.ScrollPercent()*100))
+	return PreviewStyle.
+		MaxWidth(m.Preview.Width + PreviewStyle.GetHorizontalFrameSize()).
+		Render(header + scroll + "\n" + m.Preview.View())
+}
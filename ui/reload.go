@@ -0,0 +1,102 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// reload starts a rescan, keeping the current data on screen until it
+// finishes. The history is read again too once hotspots have been shown,
+// and the diff is counted again after the scan.
+func (m *Model) reload() tea.Cmd {
+	if m.Rescanning || m.Result == nil {
+		return nil
+	}
+	m.Rescanning = true
+	if m.Churn != nil && !m.LoadingChurn {
+		m.LoadingChurn = true
+		return tea.Batch(RunCloc(m.TargetPath, m.IsGit), LoadChurn(m.TargetPath, m.IsGit, m.HotspotSince))
+	}
+	return RunCloc(m.TargetPath, m.IsGit)
+}
+
+// handleClocResult installs a scan result. After a rescan the view, sort,
+// filters and cursors are kept, with cursors following the same language
+// or path rather than the same index.
+func (m *Model) handleClocResult(msg ClocResultMsg) tea.Cmd {
+	rescan := m.Rescanning
+	m.Rescanning = false
+
+	if msg.Err != nil {
+		if rescan {
+			m.StatusMsg = "Rescan failed: " + msg.Err.Error()
+			return nil
+		}
+		m.Err = msg.Err
+		return nil
+	}
+
+	var selectedLang, selectedPath string
+	if rescan {
+		m.StatusMsg = ""
+		if langs := m.VisibleLanguages(); m.Cursor < len(langs) {
+			selectedLang = langs[m.Cursor].Name
+		}
+		if file, ok := m.SelectedFile(); ok {
+			selectedPath = file.Path
+		}
+	}
+
+	m.FullResult = msg.Result
+	m.dropLanguageGroups()
+	m.deriveResult()
+	m.CalculateColumnWidths()
+	if !rescan {
+		// Started in the changes view, which needs the scan's languages
+		if m.Mode == DiffView {
+			return tea.Batch(LoadAges(m.TargetPath, m.IsGit), m.loadChanges())
+		}
+		return LoadAges(m.TargetPath, m.IsGit)
+	}
+
+	// Attribute the new files' lines again, once authors have been shown
+	var loadAuthors tea.Cmd
+	if m.Authorship != nil && !m.LoadingAuthors {
+		m.Authorship = nil
+		loadAuthors = m.loadAuthors()
+	}
+	// And count the diff again, once it has been shown
+	var loadChanges tea.Cmd
+	if m.Changes != nil && !m.LoadingChanges {
+		m.Changes = nil
+		loadChanges = m.loadChanges()
+	}
+
+	if _, ok := m.Result.Files[m.SelectedLang]; !ok && m.Mode == FileView {
+		m.Mode = LanguageView
+		m.FileFilter = ""
+		m.PreviewFocused = false
+	}
+
+	m.selectLanguage(selectedLang)
+	m.FileCursor = 0
+	for i, file := range m.VisibleFiles() {
+		if file.Path == selectedPath {
+			m.FileCursor = i
+			break
+		}
+	}
+	m.scrollToCursors()
+
+	// Refresh the preview in case the file changed
+	m.PreviewPath = ""
+	return tea.Batch(m.syncPreview(), loadAuthors, loadChanges, LoadAges(m.TargetPath, m.IsGit))
+}
+
+// scrollToCursors adjusts the scroll offsets so both cursors are on screen
+func (m *Model) scrollToCursors() {
+	visibleRows := m.VisibleRows()
+	if m.Cursor < m.ScrollOffset || m.Cursor >= m.ScrollOffset+visibleRows {
+		m.ScrollOffset = max(m.Cursor-visibleRows+1, 0)
+	}
+	if m.FileCursor < m.FileScrollOffset || m.FileCursor >= m.FileScrollOffset+visibleRows {
+		m.FileScrollOffset = max(m.FileCursor-visibleRows+1, 0)
+	}
+}
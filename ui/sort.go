@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devin/gloc/config"
+)
+
+// SortKey is one entry of a sort stack
+type SortKey struct {
+	Col SortColumn
+	Asc bool
+}
+
+// sortColumnNames are the names used for sort keys in the session file
+var sortColumnNames = map[SortColumn]string{
+	SortByName:         "name",
+	SortByFiles:        "files",
+	SortByBlank:        "blank",
+	SortByComment:      "comment",
+	SortByCode:         "code",
+	SortByTotal:        "total",
+	SortByLanguage:     "language",
+	SortByAvgCode:      "avg_code",
+	SortByCommentRatio: "comment_ratio",
+	SortByPercent:      "percent",
+	SortByMaxLines:     "max_lines",
+	SortByMedianLines:  "median_lines",
+	SortByBytes:        "bytes",
+	SortByTestCode:     "test_code",
+	SortByProdCode:     "prod_code",
+	SortByTestRatio:    "test_ratio",
+	SortByAge:          "age",
+}
+
+// updateSort returns the sort stack after sorting by col. Sorting by the
+// primary column flips its direction; any other column replaces the stack.
+// With add set, col is appended as a further key, or flipped if already present.
+func updateSort(keys []SortKey, col SortColumn, add bool) []SortKey {
+	for i, k := range keys {
+		if k.Col == col && (add || i == 0) {
+			keys = slices.Clone(keys)
+			keys[i].Asc = !keys[i].Asc
+			return keys
+		}
+	}
+
+	// Names read best A-Z, counts largest first
+	next := SortKey{Col: col, Asc: col == SortByName || col == SortByLanguage}
+	if add {
+		return append(slices.Clone(keys), next)
+	}
+	return []SortKey{next}
+}
+
+// sortRank returns the 1-based position of col in the sort stack, or 0
+func sortRank(keys []SortKey, col SortColumn) int {
+	for i, k := range keys {
+		if k.Col == col {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// handleSort sorts the current view by col. With add set, col becomes an
+// extra sort key that breaks ties in the keys before it.
+func (m *Model) handleSort(col SortColumn, add bool) {
+	if m.Mode == LanguageView {
+		m.LangSort = updateSort(m.LangSort, col, add)
+		m.SortLanguages()
+		m.Cursor = 0
+		m.ScrollOffset = 0
+		return
+	}
+
+	if m.isReport() {
+		// Buckets keep their configured order, hotspots and authors their ranking
+		return
+	}
+	if col == SortByFiles {
+		// The all-files view has a language column in place of file counts
+		if m.Mode != AllFilesView {
+			return
+		}
+		col = SortByLanguage
+	}
+	m.FileSort = updateSort(m.FileSort, col, add)
+	m.FileCursor = 0
+	m.FileScrollOffset = 0
+}
+
+// formatSortKeys encodes a sort stack for the session file, e.g. "code:desc"
+func formatSortKeys(keys []SortKey) []string {
+	encoded := make([]string, 0, len(keys))
+	for _, k := range keys {
+		dir := "desc"
+		if k.Asc {
+			dir = "asc"
+		}
+		encoded = append(encoded, sortColumnNames[k.Col]+":"+dir)
+	}
+	return encoded
+}
+
+// parseSortKeys decodes a sort stack from the session file, skipping
+// entries it doesn't recognize
+func parseSortKeys(encoded []string) []SortKey {
+	var keys []SortKey
+	for _, entry := range encoded {
+		name, dir, _ := strings.Cut(entry, ":")
+		for col, colName := range sortColumnNames {
+			if colName == name && sortRank(keys, col) == 0 {
+				keys = append(keys, SortKey{Col: col, Asc: dir == "asc"})
+			}
+		}
+	}
+	return keys
+}
+
+// RestoreSession applies the state saved by a previous run
+func (m *Model) RestoreSession(s *config.Session) {
+	if keys := parseSortKeys(s.LanguageSort); len(keys) > 0 {
+		m.LangSort = keys
+	}
+	if keys := parseSortKeys(s.FileSort); len(keys) > 0 {
+		m.FileSort = keys
+	}
+}
+
+// Session returns the state to save for the next run
+func (m Model) Session() *config.Session {
+	return &config.Session{
+		LanguageSort: formatSortKeys(m.LangSort),
+		FileSort:     formatSortKeys(m.FileSort),
+	}
+}
+
+// sortHeader adds the sort direction to a header label, numbered by
+// priority when sorting by more than one key
+func (m Model) sortHeader(label string, col SortColumn, keys []SortKey) string {
+	rank := sortRank(keys, col)
+	if rank == 0 {
+		return label
+	}
+	arrow := "▼"
+	if keys[rank-1].Asc {
+		arrow = "▲"
+	}
+	if len(keys) > 1 {
+		arrow += strconv.Itoa(rank)
+	}
+	return label + " " + arrow
+}
@@ -2,73 +2,135 @@ package ui
 
 import "github.com/charmbracelet/lipgloss"
 
-// Styles for the UI
+// Styles for the UI, built from the active theme by ApplyTheme
 var (
 	AppStyle = lipgloss.NewStyle().Padding(1, 2)
 
 	// Header styles
+	HeaderStyle       lipgloss.Style
+	HeaderActiveStyle lipgloss.Style
+
+	// Title style
+	TitleStyle lipgloss.Style
+
+	// Badge text drawn on a language color
+	BadgeStyle lipgloss.Style
+
+	// Row styles
+	SelectedRowStyle lipgloss.Style
+	NormalRowStyle   lipgloss.Style
+
+	// Cursor style
+	CursorStyle lipgloss.Style
+
+	// Status bar
+	StatusBarStyle lipgloss.Style
+	StatusMsgStyle lipgloss.Style
+
+	// Help style
+	HelpStyle    lipgloss.Style
+	HelpKeyStyle lipgloss.Style
+
+	// Filter match highlight
+	MatchStyle lipgloss.Style
+
+	// Divider
+	DividerStyle lipgloss.Style
+
+	// Border style for tables
+	BorderStyle lipgloss.Style
+
+	// Source preview pane
+	PreviewStyle lipgloss.Style
+
+	// Number styles for different columns
+	CodeStyle    lipgloss.Style
+	CommentStyle lipgloss.Style
+	BlankStyle   lipgloss.Style
+	FilesStyle   lipgloss.Style
+	TotalStyle   lipgloss.Style
+)
+
+func init() {
+	ApplyTheme(DarkTheme)
+}
+
+// ApplyTheme rebuilds all styles from the theme's colors
+func ApplyTheme(t Theme) {
 	HeaderStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#5C5C5C")).
-			Padding(0, 1)
+		Bold(true).
+		Foreground(lipgloss.Color(t.HeaderFg)).
+		Background(lipgloss.Color(t.HeaderBg)).
+		Padding(0, 1)
 
 	HeaderActiveStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("#000000")).
-				Background(lipgloss.Color("#7DC4E4")).
-				Padding(0, 1)
+		Bold(true).
+		Foreground(lipgloss.Color(t.ActiveHeaderFg)).
+		Background(lipgloss.Color(t.Accent)).
+		Padding(0, 1)
 
-	// Title style
 	TitleStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7DC4E4")).
-			Bold(true)
+		Foreground(lipgloss.Color(t.Accent)).
+		Bold(true)
+
+	BadgeStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(t.BadgeFg)).
+		Padding(0, 1).
+		Bold(true)
 
-	// Row styles
 	SelectedRowStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#FFFFFF"))
+		Foreground(lipgloss.Color(t.Selected))
 
 	NormalRowStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CCCCCC"))
+		Foreground(lipgloss.Color(t.Normal))
 
-	// Cursor style
 	CursorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7DC4E4")).
-			Bold(true)
+		Foreground(lipgloss.Color(t.Accent)).
+		Bold(true)
 
-	// Status bar
 	StatusBarStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#888888"))
+		Foreground(lipgloss.Color(t.Status))
+
+	StatusMsgStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(t.Highlight))
 
-	// Help style
 	HelpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#626262"))
+		Foreground(lipgloss.Color(t.Help))
 
 	HelpKeyStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7DC4E4")).
-			Bold(true)
+		Foreground(lipgloss.Color(t.Accent)).
+		Bold(true)
+
+	MatchStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(t.Highlight)).
+		Bold(true).
+		Underline(true)
 
-	// Divider
 	DividerStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#4C4C4C"))
+		Foreground(lipgloss.Color(t.Border))
 
-	// Border style for tables
 	BorderStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#4C4C4C"))
+		Foreground(lipgloss.Color(t.Border))
+
+	PreviewStyle = lipgloss.NewStyle().
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderLeft(true).
+		BorderForeground(lipgloss.Color(t.Border)).
+		PaddingLeft(1).
+		MarginLeft(1)
 
-	// Number styles for different columns
 	CodeStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#A6E3A1"))
+		Foreground(lipgloss.Color(t.Code))
 
 	CommentStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#89B4FA"))
+		Foreground(lipgloss.Color(t.Comment))
 
 	BlankStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#9399B2"))
+		Foreground(lipgloss.Color(t.Blank))
 
 	FilesStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F9E2AF"))
+		Foreground(lipgloss.Color(t.Files))
 
 	TotalStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#CBA6F7"))
-)
+		Foreground(lipgloss.Color(t.Total))
+}
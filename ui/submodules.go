@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/devin/gloc/cloc"
+)
+
+// Ways of counting submodules and nested repositories
+const (
+	includeSubmodules  = "include"  // As part of the parent's languages
+	excludeSubmodules  = "exclude"  // Not at all
+	separateSubmodules = "separate" // Each as a group and bucket of its own
+)
+
+// submoduleModes is the order the submodule key cycles through
+var submoduleModes = []string{includeSubmodules, excludeSubmodules, separateSubmodules}
+
+// parseSubmoduleMode validates the configured submodule handling
+func parseSubmoduleMode(mode string) (string, error) {
+	switch mode {
+	case "":
+		return includeSubmodules, nil
+	case includeSubmodules, excludeSubmodules, separateSubmodules:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unknown submodules mode %q (want include, exclude or separate)", mode)
+}
+
+// applySubmodules leaves the submodules' files out of the result or moves
+// them into groups of their own, as the submodule mode asks
+func (m *Model) applySubmodules() {
+	if len(m.FullResult.Submodules) == 0 {
+		return
+	}
+	switch m.SubmoduleMode {
+	case excludeSubmodules:
+		m.Result = m.Result.WithoutSubmodules()
+	case separateSubmodules:
+		m.Result = m.Result.SeparateSubmodules(m.FullResult.Submodules)
+	}
+}
+
+// submoduleBuckets returns the bucket of each separated submodule's files,
+// named by its label, or nil unless submodules are separated
+func (m Model) submoduleBuckets() map[string]string {
+	if m.SubmoduleMode != separateSubmodules || m.FullResult == nil || len(m.FullResult.Submodules) == 0 {
+		return nil
+	}
+	buckets := make(map[string]string, len(m.FullResult.Submodules))
+	for _, sub := range m.FullResult.Submodules {
+		buckets[sub.Name] = sub.Label()
+	}
+	return buckets
+}
+
+// resultLanguage returns the language row listing a file, which for a
+// separated submodule's file is the submodule's own language
+func (m Model) resultLanguage(file cloc.FileInfo) string {
+	if file.Submodule != "" && m.SubmoduleMode == separateSubmodules {
+		return cloc.SubmoduleLanguage(file.Submodule, file.Language)
+	}
+	return file.Language
+}
+
+// cycleSubmodules switches between including, excluding and separating the
+// submodules
+func (m *Model) cycleSubmodules() {
+	if len(m.FullResult.Submodules) == 0 {
+		m.StatusMsg = "No submodules or nested repositories found"
+		return
+	}
+	next := 0
+	for i, mode := range submoduleModes {
+		if mode == m.SubmoduleMode {
+			next = (i + 1) % len(submoduleModes)
+		}
+	}
+	m.SubmoduleMode = submoduleModes[next]
+	m.StatusMsg = fmt.Sprintf("Submodules: %s", m.SubmoduleMode)
+	m.rederive()
+}
+
+// excludedSubmodules returns how many submodules are left out of the views
+func (m Model) excludedSubmodules() int {
+	if m.SubmoduleMode != excludeSubmodules || m.FullResult == nil {
+		return 0
+	}
+	return len(m.FullResult.Submodules)
+}
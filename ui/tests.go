@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/devin/gloc/cloc"
+)
+
+// testIcon marks test files in the file views
+const testIcon = "🧪"
+
+// parseTestPatterns validates the configured test patterns and merges them
+// over the defaults
+func parseTestPatterns(patterns map[string][]string) (cloc.TestMatcher, error) {
+	for lang, globs := range patterns {
+		for _, pattern := range globs {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("tests %q: bad path pattern %q", lang, pattern)
+			}
+		}
+	}
+	return cloc.NewTestMatcher(patterns), nil
+}
+
+// markTests flags the test files of the full scan
+func (m *Model) markTests() {
+	for _, files := range m.FullResult.Files {
+		for i := range files {
+			rel := filepath.ToSlash(m.relativePath(files[i].Path))
+			files[i].Test = m.Tests.IsTest(files[i].Language, rel)
+		}
+	}
+}
+
+// hasTests reports whether any shown language has test code
+func (m Model) hasTests() bool {
+	for _, lm := range m.Metrics {
+		if lm.TestCode > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleTests shows or hides test files
+func (m *Model) toggleTests() {
+	m.HideTests = !m.HideTests
+	m.rederive()
+}
@@ -0,0 +1,193 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/devin/gloc/config"
+	"github.com/muesli/termenv"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme contains the colors used to build the UI styles
+type Theme struct {
+	Name           string `yaml:"name"`
+	Accent         string `yaml:"accent"`
+	HeaderFg       string `yaml:"header_fg"`
+	HeaderBg       string `yaml:"header_bg"`
+	ActiveHeaderFg string `yaml:"active_header_fg"`
+	BadgeFg        string `yaml:"badge_fg"`
+	Selected       string `yaml:"selected"`
+	Normal         string `yaml:"normal"`
+	Status         string `yaml:"status"`
+	Help           string `yaml:"help"`
+	Highlight      string `yaml:"highlight"`
+	Border         string `yaml:"border"`
+	Code           string `yaml:"code"`
+	Comment        string `yaml:"comment"`
+	Blank          string `yaml:"blank"`
+	Files          string `yaml:"files"`
+	Total          string `yaml:"total"`
+	// Background is the terminal background that language colors are
+	// adjusted to contrast with. Empty means ask the terminal.
+	Background string `yaml:"background"`
+}
+
+// DarkTheme is tuned for dark terminal backgrounds
+var DarkTheme = Theme{
+	Name:           "dark",
+	Accent:         "#7DC4E4",
+	HeaderFg:       "#FFFFFF",
+	HeaderBg:       "#5C5C5C",
+	ActiveHeaderFg: "#000000",
+	BadgeFg:        "#FFFFFF",
+	Selected:       "#FFFFFF",
+	Normal:         "#CCCCCC",
+	Status:         "#888888",
+	Help:           "#626262",
+	Highlight:      "#F5A97F",
+	Border:         "#4C4C4C",
+	Code:           "#A6E3A1",
+	Comment:        "#89B4FA",
+	Blank:          "#9399B2",
+	Files:          "#F9E2AF",
+	Total:          "#CBA6F7",
+}
+
+// LightTheme is tuned for light terminal backgrounds
+var LightTheme = Theme{
+	Name:           "light",
+	Accent:         "#1E66F5",
+	HeaderFg:       "#FFFFFF",
+	HeaderBg:       "#6C6F85",
+	ActiveHeaderFg: "#FFFFFF",
+	BadgeFg:        "#FFFFFF",
+	Selected:       "#000000",
+	Normal:         "#4C4F69",
+	Status:         "#5C5F77",
+	Help:           "#8C8FA1",
+	Highlight:      "#D20F39",
+	Border:         "#BCC0CC",
+	Code:           "#40A02B",
+	Comment:        "#1E66F5",
+	Blank:          "#7C7F93",
+	Files:          "#DF8E1D",
+	Total:          "#8839EF",
+}
+
+// HighContrastTheme uses pure colors for maximum legibility
+var HighContrastTheme = Theme{
+	Name:           "high-contrast",
+	Accent:         "#00FFFF",
+	HeaderFg:       "#000000",
+	HeaderBg:       "#FFFFFF",
+	ActiveHeaderFg: "#000000",
+	BadgeFg:        "#FFFFFF",
+	Selected:       "#FFFFFF",
+	Normal:         "#FFFFFF",
+	Status:         "#FFFFFF",
+	Help:           "#C0C0C0",
+	Highlight:      "#FFFF00",
+	Border:         "#FFFFFF",
+	Code:           "#00FF00",
+	Comment:        "#00BFFF",
+	Blank:          "#C0C0C0",
+	Files:          "#FFFF00",
+	Total:          "#FF00FF",
+}
+
+// ColorblindTheme uses the Okabe-Ito palette, distinguishable with all common color vision deficiencies
+var ColorblindTheme = Theme{
+	Name:           "colorblind",
+	Accent:         "#56B4E9",
+	HeaderFg:       "#FFFFFF",
+	HeaderBg:       "#5C5C5C",
+	ActiveHeaderFg: "#000000",
+	BadgeFg:        "#FFFFFF",
+	Selected:       "#FFFFFF",
+	Normal:         "#CCCCCC",
+	Status:         "#999999",
+	Help:           "#737373",
+	Highlight:      "#E69F00",
+	Border:         "#4C4C4C",
+	Code:           "#009E73",
+	Comment:        "#0072B2",
+	Blank:          "#999999",
+	Files:          "#F0E442",
+	Total:          "#CC79A7",
+}
+
+// Themes are the built-in presets by name
+var Themes = map[string]Theme{
+	DarkTheme.Name:         DarkTheme,
+	LightTheme.Name:        LightTheme,
+	HighContrastTheme.Name: HighContrastTheme,
+	ColorblindTheme.Name:   ColorblindTheme,
+}
+
+// ResolveTheme picks the theme from config: a preset name, "auto" to match the
+// terminal background, and an optional theme file overriding individual colors
+func ResolveTheme(cfg *config.Config) (Theme, error) {
+	var theme Theme
+	switch cfg.Theme {
+	case "", "auto":
+		theme = DarkTheme
+		if !lipgloss.HasDarkBackground() {
+			theme = LightTheme
+		}
+	default:
+		preset, ok := Themes[cfg.Theme]
+		if !ok {
+			return theme, fmt.Errorf("unknown theme %q (want auto, dark, light, high-contrast or colorblind)", cfg.Theme)
+		}
+		theme = preset
+	}
+
+	if cfg.ThemeFile != "" {
+		data, err := os.ReadFile(cfg.ThemeFile)
+		if err != nil {
+			return theme, err
+		}
+		if err := yaml.Unmarshal(data, &theme); err != nil {
+			return theme, fmt.Errorf("parsing %s: %w", cfg.ThemeFile, err)
+		}
+	}
+	return theme, nil
+}
+
+// TerminalBackground returns the background color to adjust language colors
+// against: the theme's, the terminal's if it reports one, or black or white
+// to match the theme
+func TerminalBackground(theme Theme) string {
+	if theme.Background != "" {
+		return theme.Background
+	}
+	if _, unknown := termenv.BackgroundColor().(termenv.NoColor); !unknown {
+		return termenv.ConvertToRGB(termenv.BackgroundColor()).Hex()
+	}
+	if theme.Name == LightTheme.Name {
+		return "#FFFFFF"
+	}
+	return "#000000"
+}
+
+// ConfigureColor sets the color output from the --color mode (auto, always
+// or never). NO_COLOR disables colors in auto mode.
+func ConfigureColor(mode string) error {
+	switch mode {
+	case "", "auto":
+		if os.Getenv("NO_COLOR") != "" {
+			lipgloss.SetColorProfile(termenv.Ascii)
+		}
+	case "always":
+		if lipgloss.ColorProfile() == termenv.Ascii {
+			lipgloss.SetColorProfile(termenv.ANSI256)
+		}
+	case "never":
+		lipgloss.SetColorProfile(termenv.Ascii)
+	default:
+		return fmt.Errorf("unknown color mode %q (want auto, always or never)", mode)
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package ui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/devin/gloc/cloc"
+)
 
 // Update implements tea.Model
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -8,73 +12,369 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyMsg(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouseMsg(msg)
+
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
 		m.CalculateColumnWidths()
 
 	case ClocResultMsg:
+		return m, m.handleClocResult(msg)
+
+	case PreviewMsg:
+		m.handlePreviewMsg(msg)
+		return m, nil
+
+	case EditorFinishedMsg:
+		return m, m.handleEditorFinished(msg)
+
+	case FileRescannedMsg:
+		return m, m.handleFileRescanned(msg)
+
+	case ChurnMsg:
+		m.handleChurn(msg)
+		return m, nil
+
+	case AuthorsMsg:
+		m.handleAuthors(msg)
+		return m, nil
+
+	case ChangesMsg:
+		m.handleChanges(msg)
+		return m, nil
+
+	case AgesMsg:
+		m.handleAges(msg)
+		return m, nil
+
+	case ClipboardMsg:
 		if msg.Err != nil {
-			m.Err = msg.Err
-			return m, nil
+			m.StatusMsg = "Copy failed: " + msg.Err.Error()
+		} else {
+			m.StatusMsg = "Copied " + msg.What + " to clipboard"
 		}
-		m.Result = msg.Result
-		m.SortLanguages()
-		m.CalculateColumnWidths()
 		return m, nil
 	}
 
+	// Forward cursor blinks and other messages to the filter input while editing
+	if m.Filtering {
+		var cmd tea.Cmd
+		m.FilterInput, cmd = m.FilterInput.Update(msg)
+		return m, cmd
+	}
+
 	return m, nil
 }
 
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "ctrl+c":
+	m.StatusMsg = ""
+	if m.ShowHelp {
+		return m.handleHelpKey(msg)
+	}
+	if m.Filtering {
+		return m.handleFilterKey(msg)
+	}
+	if m.ShowColumns {
+		return m.handleColumnsKey(msg)
+	}
+	if m.PreviewFocused && m.isFileList() {
+		return m.handlePreviewKey(msg)
+	}
+
+	if !m.keyApplies(msg) {
+		return m, nil
+	}
+
+	k := m.Keys
+	switch {
+	case key.Matches(msg, k.ForceQuit):
 		return m, tea.Quit
-	case "q":
-		if m.Mode == FileView {
+	case key.Matches(msg, k.Quit):
+		if m.isFileList() {
+			m.FileFilter = ""
+			m.PreviewFocused = false
+			m.Mode = m.parentView()
+			return m, nil
+		}
+		if m.isReport() {
 			m.Mode = LanguageView
 			return m, nil
 		}
 		return m, tea.Quit
-	case "esc":
-		if m.Mode == FileView {
+	case key.Matches(msg, k.Back):
+		if m.activeFilter() != "" {
+			m.clearFilter()
+			return m, nil
+		}
+		if m.isFileList() {
+			m.PreviewFocused = false
+			m.Mode = m.parentView()
+			return m, nil
+		}
+		if m.isReport() {
 			m.Mode = LanguageView
 			return m, nil
 		}
-	case "enter":
-		if m.Mode == LanguageView && m.Result != nil && len(m.Result.Languages) > 0 {
-			m.SelectedLang = m.Result.Languages[m.Cursor].Name
-			m.Mode = FileView
-			m.FileCursor = 0
-			m.FileScrollOffset = 0
+	case key.Matches(msg, k.Help):
+		m.ShowHelp = true
+	case key.Matches(msg, k.Filter):
+		if m.Result != nil && !m.isReport() {
+			m.Filtering = true
+			m.FilterInput.SetValue(m.activeFilter())
+			m.FilterInput.CursorEnd()
+			return m, m.FilterInput.Focus()
+		}
+	case key.Matches(msg, k.Open):
+		switch m.Mode {
+		case LanguageView:
+			m.openSelectedLanguage()
+		case AllFilesView:
+			m.jumpToLanguage()
+		case BucketView:
+			m.openSelectedBucket()
+		case HotspotView:
+			m.openSelectedHotspot()
+		case AuthorView:
+			m.toggleAuthorExpanded()
+		case DiffView:
+			m.openSelectedChange()
+		}
+	case key.Matches(msg, k.AllFiles):
+		m.toggleAllFiles()
+	case key.Matches(msg, k.Buckets):
+		if m.Result != nil {
+			m.showBuckets(PathBuckets)
+		}
+	case key.Matches(msg, k.Owners):
+		if m.Result != nil {
+			m.showBuckets(OwnerBuckets)
+		}
+	case key.Matches(msg, k.Dirs):
+		if m.Result != nil {
+			m.showBuckets(DirBuckets)
+		}
+	case key.Matches(msg, k.Hotspots):
+		if m.Result != nil {
+			return m, m.showHotspots()
+		}
+	case key.Matches(msg, k.Authors):
+		if m.Result != nil {
+			return m, m.showAuthors()
+		}
+	case key.Matches(msg, k.Changes):
+		if m.Result != nil {
+			return m, m.toggleChanges()
+		}
+	case key.Matches(msg, k.AuthorDirs):
+		if m.Mode == AuthorView {
+			m.toggleAuthorsByDir()
 		}
-	case "up", "k":
+	case key.Matches(msg, k.Category):
+		if m.Mode == LanguageView && m.Result != nil {
+			m.cycleCategory()
+		}
+	case key.Matches(msg, k.Groups):
+		if m.Mode == LanguageView && m.Result != nil {
+			m.toggleGroups()
+		}
+	case key.Matches(msg, k.Tests):
+		if m.Result != nil {
+			m.toggleTests()
+		}
+	case key.Matches(msg, k.Submodules):
+		if m.Mode == LanguageView && m.Result != nil {
+			m.cycleSubmodules()
+		}
+	case key.Matches(msg, k.Expand):
+		if m.Mode == LanguageView && m.Result != nil {
+			m.toggleExpanded()
+		} else if m.Mode == BucketView {
+			m.toggleBucketExpanded()
+		} else if m.Mode == AuthorView {
+			m.toggleAuthorExpanded()
+		} else if m.Mode == DiffView {
+			m.toggleChangeExpanded()
+		}
+	case key.Matches(msg, k.Columns):
+		if m.Mode == LanguageView && m.Result != nil {
+			m.ShowColumns = true
+		}
+	case key.Matches(msg, k.Preview):
+		if m.isFileList() {
+			m.ShowPreview = !m.ShowPreview
+			m.PreviewFocused = false
+			m.PreviewPath = ""
+			m.resizePreview()
+		}
+	case key.Matches(msg, k.Edit):
+		if file, ok := m.SelectedFile(); ok && m.isFileList() {
+			return m, OpenInEditor(file, m.TargetPath, m.IsGit)
+		}
+	case key.Matches(msg, k.Reload):
+		return m, m.reload()
+	case key.Matches(msg, k.Yank):
+		return m, m.yankSelection()
+	case key.Matches(msg, k.YankView):
+		return m, m.yankView()
+	case key.Matches(msg, k.FocusPreview):
+		if m.isFileList() && m.ShowPreview {
+			m.PreviewFocused = true
+		}
+	case key.Matches(msg, k.Up):
 		m.handleUp()
-	case "down", "j":
+	case key.Matches(msg, k.Down):
 		m.handleDown()
-	case "1":
-		m.handleSortByName()
-	case "2":
-		m.handleSortByFiles()
-	case "3":
-		m.handleSortByBlank()
-	case "4":
-		m.handleSortByComment()
-	case "5":
-		m.handleSortByCode()
-	case "6":
-		m.handleSortByTotal()
-	case "home", "g":
+	case key.Matches(msg, k.SortName):
+		m.handleSort(SortByName, false)
+	case key.Matches(msg, k.SortFiles):
+		m.handleSort(SortByFiles, false)
+	case key.Matches(msg, k.SortBlank):
+		m.handleSort(SortByBlank, false)
+	case key.Matches(msg, k.SortComment):
+		m.handleSort(SortByComment, false)
+	case key.Matches(msg, k.SortCode):
+		m.handleSort(SortByCode, false)
+	case key.Matches(msg, k.SortTotal):
+		m.handleSort(SortByTotal, false)
+	case key.Matches(msg, k.ThenSortName):
+		m.handleSort(SortByName, true)
+	case key.Matches(msg, k.ThenSortFiles):
+		m.handleSort(SortByFiles, true)
+	case key.Matches(msg, k.ThenSortBlank):
+		m.handleSort(SortByBlank, true)
+	case key.Matches(msg, k.ThenSortComment):
+		m.handleSort(SortByComment, true)
+	case key.Matches(msg, k.ThenSortCode):
+		m.handleSort(SortByCode, true)
+	case key.Matches(msg, k.ThenSortTotal):
+		m.handleSort(SortByTotal, true)
+	case key.Matches(msg, k.Top):
 		m.handleHome()
-	case "end", "G":
+	case key.Matches(msg, k.Bottom):
 		m.handleEnd()
 	}
 
+	return m, m.syncPreview()
+}
+
+// handleHelpKey handles keys while the help overlay is shown
+func (m Model) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.Keys.ForceQuit):
+		return m, tea.Quit
+	case key.Matches(msg, m.Keys.Help, m.Keys.Back, m.Keys.Quit):
+		m.ShowHelp = false
+	}
 	return m, nil
 }
 
+// openSelectedLanguage switches to the file view for the language under the cursor
+func (m *Model) openSelectedLanguage() {
+	langs := m.VisibleLanguages()
+	if m.Cursor >= len(langs) {
+		return
+	}
+	m.SelectedLang = langs[m.Cursor].Name
+	m.Mode = FileView
+	m.FileFilter = ""
+	m.FileCursor = 0
+	m.FileScrollOffset = 0
+}
+
+// toggleAllFiles switches between the language view and the flat list of all files
+func (m *Model) toggleAllFiles() {
+	if m.Result == nil {
+		return
+	}
+	m.FileFilter = ""
+	m.FileCursor = 0
+	m.FileScrollOffset = 0
+	m.PreviewFocused = false
+	m.SelectedBucket = ""
+	if m.Mode == AllFilesView {
+		m.Mode = LanguageView
+	} else {
+		m.Mode = AllFilesView
+	}
+}
+
+// jumpToLanguage opens the file view of the selected file's language with
+// the same file selected
+func (m *Model) jumpToLanguage() {
+	if file, ok := m.SelectedFile(); ok {
+		m.openFile(file)
+	}
+}
+
+// openFile opens the file view of a file's language with the file selected
+func (m *Model) openFile(file cloc.FileInfo) {
+	lang := m.resultLanguage(file)
+	m.selectLanguage(lang)
+
+	m.SelectedBucket = ""
+	m.SelectedLang = lang
+	m.Mode = FileView
+	m.FileFilter = ""
+	m.FileCursor = 0
+	for i, f := range m.VisibleFiles() {
+		if f.Path == file.Path {
+			m.FileCursor = i
+			break
+		}
+	}
+	m.scrollToCursors()
+}
+
+// handleFilterKey handles keys while the filter input is focused
+func (m Model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Printable keys always go to the input, so only non-rune keys navigate
+	navKey := msg.Type != tea.KeyRunes
+
+	switch {
+	case key.Matches(msg, m.Keys.ForceQuit):
+		return m, tea.Quit
+	case key.Matches(msg, m.Keys.ClearFilter):
+		m.clearFilter()
+		return m, nil
+	case key.Matches(msg, m.Keys.ApplyFilter):
+		m.Filtering = false
+		m.FilterInput.Blur()
+		return m, nil
+	case navKey && key.Matches(msg, m.Keys.Up):
+		m.handleUp()
+		return m, m.syncPreview()
+	case navKey && key.Matches(msg, m.Keys.Down):
+		m.handleDown()
+		return m, m.syncPreview()
+	}
+
+	var cmd tea.Cmd
+	m.FilterInput, cmd = m.FilterInput.Update(msg)
+	if value := m.FilterInput.Value(); value != m.activeFilter() {
+		m.setActiveFilter(value)
+	}
+	return m, tea.Batch(cmd, m.syncPreview())
+}
+
 func (m *Model) handleUp() {
+	if m.Mode == BucketView {
+		m.setBucketCursor(m.BucketCursor - 1)
+		return
+	}
+	if m.Mode == AuthorView {
+		m.setAuthorCursor(m.AuthorCursor - 1)
+		return
+	}
+	if m.Mode == DiffView {
+		m.setChangeCursor(m.ChangeCursor - 1)
+		return
+	}
+	if m.Mode == HotspotView {
+		m.setHotspotCursor(m.HotspotCursor - 1)
+		return
+	}
 	if m.Mode == LanguageView {
 		if m.Cursor > 0 {
 			m.Cursor--
@@ -93,8 +393,24 @@ func (m *Model) handleUp() {
 }
 
 func (m *Model) handleDown() {
+	if m.Mode == BucketView {
+		m.setBucketCursor(m.BucketCursor + 1)
+		return
+	}
+	if m.Mode == AuthorView {
+		m.setAuthorCursor(m.AuthorCursor + 1)
+		return
+	}
+	if m.Mode == DiffView {
+		m.setChangeCursor(m.ChangeCursor + 1)
+		return
+	}
+	if m.Mode == HotspotView {
+		m.setHotspotCursor(m.HotspotCursor + 1)
+		return
+	}
 	if m.Mode == LanguageView && m.Result != nil {
-		if m.Cursor < len(m.Result.Languages)-1 {
+		if m.Cursor < len(m.VisibleLanguages())-1 {
 			m.Cursor++
 			visibleRows := m.VisibleRows()
 			if m.Cursor >= m.ScrollOffset+visibleRows {
@@ -102,7 +418,7 @@ func (m *Model) handleDown() {
 			}
 		}
 	} else if m.Result != nil {
-		files := m.Result.Files[m.SelectedLang]
+		files := m.VisibleFiles()
 		if m.FileCursor < len(files)-1 {
 			m.FileCursor++
 			visibleRows := m.VisibleRows()
@@ -113,155 +429,58 @@ func (m *Model) handleDown() {
 	}
 }
 
-func (m *Model) handleSortByName() {
-	if m.Mode == LanguageView {
-		if m.SortCol == SortByName {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByName
-			m.SortAsc = true
-		}
-		m.SortLanguages()
-		m.Cursor = 0
-		m.ScrollOffset = 0
-	} else {
-		if m.FileSortCol == SortByName {
-			m.FileSortAsc = !m.FileSortAsc
-		} else {
-			m.FileSortCol = SortByName
-			m.FileSortAsc = true
-		}
-		m.FileCursor = 0
-		m.FileScrollOffset = 0
+func (m *Model) handleHome() {
+	if m.Mode == BucketView {
+		m.setBucketCursor(0)
+		return
 	}
-}
-
-func (m *Model) handleSortByFiles() {
-	if m.Mode == LanguageView {
-		if m.SortCol == SortByFiles {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByFiles
-			m.SortAsc = false
-		}
-		m.SortLanguages()
-		m.Cursor = 0
-		m.ScrollOffset = 0
+	if m.Mode == AuthorView {
+		m.setAuthorCursor(0)
+		return
 	}
-}
-
-func (m *Model) handleSortByBlank() {
-	if m.Mode == LanguageView {
-		if m.SortCol == SortByBlank {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByBlank
-			m.SortAsc = false
-		}
-		m.SortLanguages()
-		m.Cursor = 0
-		m.ScrollOffset = 0
-	} else {
-		if m.FileSortCol == SortByBlank {
-			m.FileSortAsc = !m.FileSortAsc
-		} else {
-			m.FileSortCol = SortByBlank
-			m.FileSortAsc = false
-		}
-		m.FileCursor = 0
-		m.FileScrollOffset = 0
+	if m.Mode == DiffView {
+		m.setChangeCursor(0)
+		return
 	}
-}
-
-func (m *Model) handleSortByComment() {
-	if m.Mode == LanguageView {
-		if m.SortCol == SortByComment {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByComment
-			m.SortAsc = false
-		}
-		m.SortLanguages()
-		m.Cursor = 0
-		m.ScrollOffset = 0
-	} else {
-		if m.FileSortCol == SortByComment {
-			m.FileSortAsc = !m.FileSortAsc
-		} else {
-			m.FileSortCol = SortByComment
-			m.FileSortAsc = false
-		}
-		m.FileCursor = 0
-		m.FileScrollOffset = 0
+	if m.Mode == HotspotView {
+		m.setHotspotCursor(0)
+		return
 	}
-}
-
-func (m *Model) handleSortByCode() {
 	if m.Mode == LanguageView {
-		if m.SortCol == SortByCode {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByCode
-			m.SortAsc = false
-		}
-		m.SortLanguages()
 		m.Cursor = 0
 		m.ScrollOffset = 0
 	} else {
-		if m.FileSortCol == SortByCode {
-			m.FileSortAsc = !m.FileSortAsc
-		} else {
-			m.FileSortCol = SortByCode
-			m.FileSortAsc = false
-		}
 		m.FileCursor = 0
 		m.FileScrollOffset = 0
 	}
 }
 
-func (m *Model) handleSortByTotal() {
-	if m.Mode == LanguageView {
-		if m.SortCol == SortByTotal {
-			m.SortAsc = !m.SortAsc
-		} else {
-			m.SortCol = SortByTotal
-			m.SortAsc = false
-		}
-		m.SortLanguages()
-		m.Cursor = 0
-		m.ScrollOffset = 0
-	} else {
-		if m.FileSortCol == SortByTotal {
-			m.FileSortAsc = !m.FileSortAsc
-		} else {
-			m.FileSortCol = SortByTotal
-			m.FileSortAsc = false
-		}
-		m.FileCursor = 0
-		m.FileScrollOffset = 0
+func (m *Model) handleEnd() {
+	if m.Mode == BucketView {
+		m.setBucketCursor(len(m.bucketRows()) - 1)
+		return
 	}
-}
-
-func (m *Model) handleHome() {
-	if m.Mode == LanguageView {
-		m.Cursor = 0
-		m.ScrollOffset = 0
-	} else {
-		m.FileCursor = 0
-		m.FileScrollOffset = 0
+	if m.Mode == AuthorView {
+		m.setAuthorCursor(len(m.authorRows()) - 1)
+		return
+	}
+	if m.Mode == DiffView {
+		m.setChangeCursor(len(m.changeRows()) - 1)
+		return
+	}
+	if m.Mode == HotspotView {
+		m.setHotspotCursor(len(m.Hotspots) - 1)
+		return
 	}
-}
-
-func (m *Model) handleEnd() {
 	if m.Mode == LanguageView && m.Result != nil {
-		m.Cursor = len(m.Result.Languages) - 1
+		m.Cursor = max(len(m.VisibleLanguages())-1, 0)
 		visibleRows := m.VisibleRows()
 		if m.Cursor >= visibleRows {
 			m.ScrollOffset = m.Cursor - visibleRows + 1
 		}
 	} else if m.Result != nil {
-		files := m.Result.Files[m.SelectedLang]
-		m.FileCursor = len(files) - 1
+		files := m.VisibleFiles()
+		m.FileCursor = max(len(files)-1, 0)
 		visibleRows := m.VisibleRows()
 		if m.FileCursor >= visibleRows {
 			m.FileScrollOffset = m.FileCursor - visibleRows + 1
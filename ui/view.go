@@ -2,15 +2,33 @@ package ui
 
 import (
 	"fmt"
-	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/devin/gloc/colors"
 )
 
+// viewHead renders what the current view shows above its table
+func (m Model) viewHead() string {
+	switch m.Mode {
+	case LanguageView:
+		return m.languageHead()
+	case BucketView:
+		return m.bucketHead()
+	case HotspotView:
+		return m.hotspotHead()
+	case AuthorView:
+		return m.authorHead()
+	case DiffView:
+		return m.diffHead()
+	}
+	return m.fileHead()
+}
+
 // View implements tea.Model
 func (m Model) View() string {
 	if m.Err != nil {
@@ -21,11 +39,28 @@ func (m Model) View() string {
 		return AppStyle.Render("Loading...")
 	}
 
+	if m.ShowHelp {
+		return AppStyle.Width(m.Width).Render(m.renderHelpOverlay())
+	}
+
+	if m.ShowColumns {
+		return AppStyle.Width(m.Width).Render(m.renderColumnChooser())
+	}
+
 	var b strings.Builder
 
-	if m.Mode == LanguageView {
+	switch m.Mode {
+	case LanguageView:
 		m.renderLanguageView(&b)
-	} else {
+	case BucketView:
+		m.renderBucketView(&b)
+	case HotspotView:
+		m.renderHotspotView(&b)
+	case AuthorView:
+		m.renderAuthorView(&b)
+	case DiffView:
+		m.renderDiffView(&b)
+	default:
 		m.renderFileView(&b)
 	}
 
@@ -38,105 +73,143 @@ func (m Model) View() string {
 	return AppStyle.Width(m.Width).Render(b.String())
 }
 
-func (m Model) renderLanguageView(b *strings.Builder) {
-	// Title
-	title := TitleStyle.Render(fmt.Sprintf(" 📊 gloc - %s ", m.TargetPath))
-	b.WriteString(title)
+// languageHead renders the title above the language table
+func (m Model) languageHead() string {
+	var b strings.Builder
+	b.WriteString(TitleStyle.Render(fmt.Sprintf(" 📊 gloc - %s ", m.TargetPath)))
+	if m.Category != "" {
+		b.WriteString(" " + HelpKeyStyle.Render(m.Category))
+	}
+	m.renderFilter(&b)
 	b.WriteString("\n\n")
+	return b.String()
+}
+
+func (m Model) renderLanguageView(b *strings.Builder) {
+	b.WriteString(m.languageHead())
 
+	t, rowCount := m.languageTable()
+	b.WriteString(t.Render())
+	b.WriteString("\n")
+
+	// Pad with empty lines if needed
+	for i := rowCount; i < m.VisibleRows(); i++ {
+		b.WriteString("\n")
+	}
+
+	if m.summaryHeight() > 0 {
+		b.WriteString(m.renderCategorySummary())
+		b.WriteString("\n")
+	}
+}
+
+// languageTable builds the table for the visible window of the language view
+func (m Model) languageTable() (*table.Table, int) {
 	// Build table data
+	langs := m.VisibleLanguages()
 	visibleRows := m.VisibleRows()
 	endIdx := m.ScrollOffset + visibleRows
-	if endIdx > len(m.Result.Languages) {
-		endIdx = len(m.Result.Languages)
+	if endIdx > len(langs) {
+		endIdx = len(langs)
 	}
 
+	columns := m.activeColumns()
 	var rows [][]string
 	for i := m.ScrollOffset; i < endIdx; i++ {
-		lang := m.Result.Languages[i]
-		total := lang.Code + lang.Comment + lang.Blank
+		lang := langs[i]
 
 		cursor := "  "
 		if i == m.Cursor {
 			cursor = CursorStyle.Render("▶ ")
 		}
 
-		// Color dot
-		color := colors.GetColor(lang.Name)
-		colorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
-		dot := colorStyle.Render("●")
-
-		rows = append(rows, []string{
-			cursor + dot + " " + lang.Name,
-			strconv.Itoa(lang.Files),
-			strconv.Itoa(lang.Blank),
-			strconv.Itoa(lang.Comment),
-			strconv.Itoa(lang.Code),
-			strconv.Itoa(total),
-		})
+		// Truncate rather than wrap long names; the table wraps at the width less the header padding
+		label := cursor + m.languageLabel(lang.Name, highlightMatches(lang.Name, matchIndexes(m.LangFilter, lang.Name)))
+		row := []string{ansi.Truncate(label, m.ColLanguage-HeaderStyle.GetHorizontalPadding(), "…")}
+		for _, col := range columns {
+			row = append(row, col.Format(lang, m.Metrics[lang.Name]))
+		}
+		rows = append(rows, row)
 	}
 
 	// Create table
+	sortColumns := m.languageColumns()
 	t := table.New().
 		Border(lipgloss.HiddenBorder()).
 		Headers(m.languageHeaders()...).
 		Rows(rows...).
 		Width(m.ContentWidth()).
 		StyleFunc(func(row, col int) lipgloss.Style {
+			width := m.langColumnWidth(col)
+
 			// Header row
 			if row == table.HeaderRow {
-				if col == m.sortColIndex(m.SortCol) {
-					return HeaderActiveStyle.Align(lipgloss.Center)
+				if sortRank(m.LangSort, sortColumns[col]) > 0 {
+					return HeaderActiveStyle.Align(lipgloss.Center).Width(width)
 				}
-				return HeaderStyle.Align(lipgloss.Center)
+				return HeaderStyle.Align(lipgloss.Center).Width(width)
 			}
 
 			// Data rows - first column left-aligned, rest right-aligned
 			if col == 0 {
-				return lipgloss.NewStyle()
+				return lipgloss.NewStyle().Width(width)
 			}
 
 			// Right-align and color numeric columns
-			switch col {
-			case 1:
-				return FilesStyle.Align(lipgloss.Right)
-			case 2:
-				return BlankStyle.Align(lipgloss.Right)
-			case 3:
-				return CommentStyle.Align(lipgloss.Right)
-			case 4:
-				return CodeStyle.Align(lipgloss.Right)
-			case 5:
-				return TotalStyle.Align(lipgloss.Right)
-			default:
-				return lipgloss.NewStyle().Align(lipgloss.Right)
+			if col-1 < len(columns) {
+				return columns[col-1].Style().Align(lipgloss.Right).Width(width)
 			}
+			return lipgloss.NewStyle().Align(lipgloss.Right)
 		})
 
-	b.WriteString(t.Render())
-	b.WriteString("\n")
+	return t, len(rows)
+}
 
-	// Pad with empty lines if needed
-	for i := endIdx - m.ScrollOffset; i < visibleRows; i++ {
-		b.WriteString("\n")
+// fileHead renders the title above the file table, in the language's
+// color for a language's files
+func (m Model) fileHead() string {
+	var title string
+	if total, ok := m.selectedBucketTotal(); ok && m.Mode == AllFilesView {
+		icon := "📦"
+		switch m.BucketKind {
+		case OwnerBuckets:
+			icon = "👥"
+		case DirBuckets:
+			icon = "📁"
+		}
+		title = TitleStyle.Render(fmt.Sprintf(" %s %s Files - %s ", icon, total.Name, m.TargetPath))
+	} else if m.Mode == AllFilesView {
+		title = TitleStyle.Render(fmt.Sprintf(" 📂 All Files - %s ", m.TargetPath))
+	} else {
+		langColor := m.languageColor(m.SelectedLang)
+		titleBg := BadgeStyle.Background(lipgloss.Color(langColor))
+		title = titleBg.Render(fmt.Sprintf(" 📁 %s Files ", m.SelectedLang))
 	}
+	var b strings.Builder
+	b.WriteString(title)
+	m.renderFilter(&b)
+	b.WriteString("\n\n")
+	return b.String()
 }
 
 func (m Model) renderFileView(b *strings.Builder) {
-	// Title with language color
-	langColor := colors.GetColor(m.SelectedLang)
-	titleBg := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFFFFF")).
-		Background(lipgloss.Color(langColor)).
-		Padding(0, 1).
-		Bold(true)
-
-	title := titleBg.Render(fmt.Sprintf(" 📁 %s Files ", m.SelectedLang))
-	b.WriteString(title)
-	b.WriteString("\n\n")
+	b.WriteString(m.fileHead())
+
+	t, rowCount := m.fileTable()
 
+	// Pad with empty lines if needed
+	rendered := t.Render() + strings.Repeat("\n", m.VisibleRows()-rowCount)
+	if m.ShowPreview {
+		rendered = lipgloss.JoinHorizontal(lipgloss.Top, rendered, m.renderPreview())
+	}
+	b.WriteString(rendered)
+	b.WriteString("\n")
+}
+
+// fileTable builds the table for the visible window of the file view
+func (m Model) fileTable() (*table.Table, int) {
 	// Build table data
-	files := m.SortFiles(m.SelectedLang)
+	files := m.VisibleFiles()
 	visibleRows := m.VisibleRows()
 	endIdx := m.FileScrollOffset + visibleRows
 	if endIdx > len(files) {
@@ -154,174 +227,290 @@ func (m Model) renderFileView(b *strings.Builder) {
 		}
 
 		// Get relative path
-		displayPath := file.Path
-		if rel, err := filepath.Rel(m.TargetPath, file.Path); err == nil {
-			displayPath = rel
-		}
+		displayPath := m.relativePath(file.Path)
+		matches := matchIndexes(m.FileFilter, displayPath)
 
-		// Truncate if too long
+		// Truncate if too long, shifting match offsets to the truncated path
 		maxPathLen := 60
+		if m.Mode == AllFilesView {
+			maxPathLen = 48
+		}
+		if m.ShowPreview {
+			maxPathLen = max(min(maxPathLen, m.fileTableWidth()-44), 20)
+		}
+		if m.showFileAges() {
+			// Leave room for the age column
+			maxPathLen = max(min(maxPathLen, m.fileTableWidth()-56), 20)
+		}
+		icon := ""
+		if file.Test {
+			icon = " " + testIcon
+			maxPathLen -= lipgloss.Width(icon)
+		}
 		if len(displayPath) > maxPathLen {
-			displayPath = "…" + displayPath[len(displayPath)-maxPathLen+1:]
+			cut := len(displayPath) - maxPathLen + 1
+			displayPath = "…" + displayPath[cut:]
+			var shifted []int
+			for _, idx := range matches {
+				if idx >= cut {
+					shifted = append(shifted, idx-cut+len("…"))
+				}
+			}
+			matches = shifted
 		}
 
-		rows = append(rows, []string{
-			cursor + displayPath,
+		row := []string{cursor + highlightMatches(displayPath, matches) + icon}
+		if m.Mode == AllFilesView {
+			// Language with its color dot
+			color := colors.GetColor(file.Language)
+			dot := lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render("●")
+			row = append(row, dot+" "+file.Language)
+		}
+		row = append(row,
 			strconv.Itoa(file.Blank),
 			strconv.Itoa(file.Comment),
 			strconv.Itoa(file.Code),
 			strconv.Itoa(total),
-		})
+		)
+		if m.showFileAges() {
+			row = append(row, formatAge(file.Modified))
+		}
+		rows = append(rows, row)
+	}
+
+	// The all-files view has an extra language column before the counts
+	numericStart := 1
+	if m.Mode == AllFilesView {
+		numericStart = 2
 	}
 
 	// Create table
+	sortColumns := m.fileColumns()
 	t := table.New().
 		Border(lipgloss.HiddenBorder()).
 		Headers(m.fileHeaders()...).
 		Rows(rows...).
-		Width(m.ContentWidth()).
+		Width(m.fileTableWidth()).
 		StyleFunc(func(row, col int) lipgloss.Style {
 			// Header row
 			if row == table.HeaderRow {
-				if col == m.fileSortColIndex(m.FileSortCol) {
+				if sortRank(m.FileSort, sortColumns[col]) > 0 {
 					return HeaderActiveStyle.Align(lipgloss.Center)
 				}
 				return HeaderStyle.Align(lipgloss.Center)
 			}
 
-			// Data rows - first column left-aligned, rest right-aligned
-			if col == 0 {
+			// Data rows - text columns left-aligned, rest right-aligned
+			if col < numericStart {
 				return lipgloss.NewStyle()
 			}
 
 			// Right-align and color numeric columns
-			switch col {
-			case 1:
+			switch col - numericStart {
+			case 0:
 				return BlankStyle.Align(lipgloss.Right)
-			case 2:
+			case 1:
 				return CommentStyle.Align(lipgloss.Right)
-			case 3:
+			case 2:
 				return CodeStyle.Align(lipgloss.Right)
-			case 4:
+			case 3:
 				return TotalStyle.Align(lipgloss.Right)
 			default:
 				return lipgloss.NewStyle().Align(lipgloss.Right)
 			}
 		})
 
-	b.WriteString(t.Render())
-	b.WriteString("\n")
+	return t, len(rows)
+}
 
-	// Pad with empty lines if needed
-	for i := endIdx - m.FileScrollOffset; i < visibleRows; i++ {
-		b.WriteString("\n")
+// renderFilter renders the filter input, or the applied filter, after the title
+func (m Model) renderFilter(b *strings.Builder) {
+	if m.Filtering {
+		b.WriteString("  ")
+		b.WriteString(m.FilterInput.View())
+	} else if filter := m.activeFilter(); filter != "" {
+		b.WriteString("  ")
+		b.WriteString(HelpKeyStyle.Render("/") + HelpStyle.Render(filter))
 	}
 }
 
 func (m Model) renderStatusBar(b *strings.Builder) {
+	label := "Total"
+	if m.Category != "" {
+		label = strings.ToUpper(m.Category[:1]) + m.Category[1:]
+	}
 	total := m.Result.Total
+	if bucketTotal, ok := m.selectedBucketTotal(); ok && m.Mode == AllFilesView {
+		label = bucketTotal.Name
+		total = bucketTotal
+	}
+	if m.activeFilter() != "" {
+		label = "Filtered"
+		total = m.filteredTotals()
+	}
 	totalLines := total.Code + total.Comment + total.Blank
 
 	statusContent := fmt.Sprintf(
-		"Total: %s files │ %s blank │ %s comment │ %s code │ %s lines",
+		"%s: %s files │ %s blank │ %s comment │ %s code │ %s lines",
+		label,
 		FilesStyle.Render(strconv.Itoa(total.Files)),
 		BlankStyle.Render(strconv.Itoa(total.Blank)),
 		CommentStyle.Render(strconv.Itoa(total.Comment)),
 		CodeStyle.Render(strconv.Itoa(total.Code)),
 		TotalStyle.Render(strconv.Itoa(totalLines)),
 	)
+	if n := m.unownedFiles(); n > 0 && m.Mode == BucketView {
+		statusContent += "  " + StatusMsgStyle.Render(fmt.Sprintf("%d files without an owner", n))
+	}
+	if m.HideTests {
+		statusContent += "  " + HelpStyle.Render("tests hidden")
+	}
+	if m.excludedSubmodules() > 0 {
+		statusContent += "  " + HelpStyle.Render("submodules excluded")
+	}
+	if m.Rescanning {
+		statusContent += "  " + StatusMsgStyle.Render("⟳ rescanning…")
+	}
+	if m.StatusMsg != "" {
+		statusContent += "  " + StatusMsgStyle.Render(m.StatusMsg)
+	}
 	b.WriteString("\n")
 	b.WriteString(StatusBarStyle.Render(statusContent))
 	b.WriteString("\n")
 }
 
 func (m Model) renderHelp(b *strings.Builder) {
-	var help string
-	if m.Mode == LanguageView {
-		help = fmt.Sprintf(
-			"%s navigate • %s view files • %s sort • %s quit",
-			HelpKeyStyle.Render("↑/↓"),
-			HelpKeyStyle.Render("enter"),
-			HelpKeyStyle.Render("1-6"),
-			HelpKeyStyle.Render("q"),
-		)
-	} else {
-		help = fmt.Sprintf(
-			"%s navigate • %s sort • %s back • %s quit",
-			HelpKeyStyle.Render("↑/↓"),
-			HelpKeyStyle.Render("1,3-6"),
-			HelpKeyStyle.Render("esc/q"),
-			HelpKeyStyle.Render("ctrl+c"),
-		)
+	var entries []string
+	for _, entry := range m.shortHelp() {
+		entries = append(entries, HelpKeyStyle.Render(entry[0])+" "+entry[1])
+	}
+	b.WriteString(HelpStyle.Render(strings.Join(entries, " • ")))
+}
+
+// renderHelpOverlay renders every binding for the current view, generated from the keymap
+func (m Model) renderHelpOverlay() string {
+	var b strings.Builder
+
+	view := "Language view"
+	switch {
+	case m.Filtering:
+		view = "Filter"
+	case m.isFileList() && m.PreviewFocused:
+		view = "Source preview"
+	case m.Mode == FileView:
+		view = "File view"
+	case m.Mode == AllFilesView:
+		view = "All files"
+	case m.Mode == BucketView && m.BucketKind == OwnerBuckets:
+		view = "Owners"
+	case m.Mode == BucketView && m.BucketKind == DirBuckets:
+		view = "Directories"
+	case m.Mode == BucketView:
+		view = "Buckets"
+	case m.Mode == HotspotView:
+		view = "Hotspots"
+	case m.Mode == AuthorView:
+		view = "Authors"
+	case m.Mode == DiffView:
+		view = "Changes"
+	}
+	b.WriteString(TitleStyle.Render(" ⌨ Keybindings - " + view + " "))
+	b.WriteString("\n")
+
+	for _, section := range m.helpSections() {
+		b.WriteString("\n")
+		b.WriteString(HeaderStyle.Render(section.Title))
+		b.WriteString("\n")
+		for _, k := range section.Keys {
+			if !k.Binding.Enabled() {
+				continue
+			}
+			help := k.Binding.Help()
+			b.WriteString("  ")
+			b.WriteString(HelpKeyStyle.Width(16).Render(help.Key))
+			b.WriteString(NormalRowStyle.Render(help.Desc))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(HelpStyle.Render(fmt.Sprintf("Press %s or %s to close", firstKey(m.Keys.Help), firstKey(m.Keys.Back))))
+	return b.String()
+}
+
+// langColumnWidth returns the width of a language table column
+func (m Model) langColumnWidth(col int) int {
+	if col > 0 && col-1 < len(m.LangColWidths) {
+		return m.LangColWidths[col-1]
 	}
-	b.WriteString(HelpStyle.Render(help))
+	return m.ColLanguage
+}
+
+// languageColumns returns the sort column of each language table column
+func (m Model) languageColumns() []SortColumn {
+	columns := []SortColumn{SortByName}
+	for _, col := range m.activeColumns() {
+		columns = append(columns, col.Sort)
+	}
+	return columns
 }
 
 func (m Model) languageHeaders() []string {
-	return []string{
-		m.sortHeader("[1] Language", SortByName, m.SortCol, m.SortAsc),
-		m.sortHeader("[2] Files", SortByFiles, m.SortCol, m.SortAsc),
-		m.sortHeader("[3] Blank", SortByBlank, m.SortCol, m.SortAsc),
-		m.sortHeader("[4] Comment", SortByComment, m.SortCol, m.SortAsc),
-		m.sortHeader("[5] Code", SortByCode, m.SortCol, m.SortAsc),
-		m.sortHeader("[6] Total", SortByTotal, m.SortCol, m.SortAsc),
+	headers := []string{fitHeader(m.sortHeader("[1] Language", SortByName, m.LangSort), m.ColLanguage)}
+	for i, col := range m.activeColumns() {
+		headers = append(headers, fitHeader(m.sortHeader(col.Title, col.Sort, m.LangSort), m.LangColWidths[i]))
 	}
+	return headers
 }
 
-func (m Model) fileHeaders() []string {
-	return []string{
-		m.sortHeader("[1] File", SortByName, m.FileSortCol, m.FileSortAsc),
-		m.sortHeader("[3] Blank", SortByBlank, m.FileSortCol, m.FileSortAsc),
-		m.sortHeader("[4] Comment", SortByComment, m.FileSortCol, m.FileSortAsc),
-		m.sortHeader("[5] Code", SortByCode, m.FileSortCol, m.FileSortAsc),
-		m.sortHeader("[6] Total", SortByTotal, m.FileSortCol, m.FileSortAsc),
+// fitHeader shortens a header to fit a column on narrow terminals, first by
+// dropping its key hint and then by truncating it
+func fitHeader(header string, width int) string {
+	width -= HeaderStyle.GetHorizontalPadding()
+	if width <= 0 || lipgloss.Width(header) <= width {
+		return header
 	}
+	if _, label, ok := strings.Cut(header, "] "); ok {
+		header = label
+	}
+	return ansi.Truncate(header, width, "…")
 }
 
-func (m Model) sortHeader(label string, col SortColumn, currentSort SortColumn, asc bool) string {
-	if col == currentSort {
-		if asc {
-			return label + " ▲"
-		}
-		return label + " ▼"
+// showFileAges reports whether the file table has an age column: once the
+// history has been read, and when it fits
+func (m Model) showFileAges() bool {
+	minWidth := 86
+	if m.Mode == AllFilesView {
+		minWidth += 16
 	}
-	return label
+	return m.FileTimes != nil && m.fileTableWidth() >= minWidth
 }
 
-// sortColIndex returns the column index for highlighting the active sort column
-func (m Model) sortColIndex(col SortColumn) int {
-	switch col {
-	case SortByName:
-		return 0
-	case SortByFiles:
-		return 1
-	case SortByBlank:
-		return 2
-	case SortByComment:
-		return 3
-	case SortByCode:
-		return 4
-	case SortByTotal:
-		return 5
-	default:
-		return -1
+// fileColumns returns the sort column of each file table column
+func (m Model) fileColumns() []SortColumn {
+	columns := []SortColumn{SortByName, SortByBlank, SortByComment, SortByCode, SortByTotal}
+	if m.Mode == AllFilesView {
+		columns = []SortColumn{SortByName, SortByLanguage, SortByBlank, SortByComment, SortByCode, SortByTotal}
+	}
+	if m.showFileAges() {
+		columns = append(columns, SortByAge)
 	}
+	return columns
 }
 
-// fileSortColIndex returns the column index for file view
-func (m Model) fileSortColIndex(col SortColumn) int {
-	switch col {
-	case SortByName:
-		return 0
-	case SortByBlank:
-		return 1
-	case SortByComment:
-		return 2
-	case SortByCode:
-		return 3
-	case SortByTotal:
-		return 4
-	default:
-		return -1
+func (m Model) fileHeaders() []string {
+	headers := []string{
+		m.sortHeader("[1] File", SortByName, m.FileSort),
+		m.sortHeader("[3] Blank", SortByBlank, m.FileSort),
+		m.sortHeader("[4] Comment", SortByComment, m.FileSort),
+		m.sortHeader("[5] Code", SortByCode, m.FileSort),
+		m.sortHeader("[6] Total", SortByTotal, m.FileSort),
+	}
+	if m.Mode == AllFilesView {
+		headers = slices.Insert(headers, 1, m.sortHeader("[2] Language", SortByLanguage, m.FileSort))
+	}
+	if m.showFileAges() {
+		headers = append(headers, m.sortHeader("Age", SortByAge, m.FileSort))
 	}
+	return headers
 }